@@ -1,13 +1,29 @@
 package app
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
 )
 
 // User action functions - triggered by keyboard shortcuts
 
-// confirmCancelRun shows confirmation dialog for cancelling a run
+// bulkCancel is the name of the bulk cancel action
+const bulkCancel = "Cancel"
+
+// confirmCancelRun shows confirmation dialog for cancelling a run,
+// or every marked run that is still running
 func (a *App) confirmCancelRun() tea.Cmd {
+	if a.runs.MarkedCount() > 0 {
+		return a.confirmBulkAction(bulkCancel, (github.Run).IsRunning,
+			func(ctx context.Context, client github.Client, repo github.Repository, runID int64) error {
+				return client.CancelRun(ctx, repo, runID)
+			})
+	}
 	run, ok := a.runs.Selected()
 	if !ok || !run.IsRunning() {
 		return nil
@@ -20,22 +36,197 @@ func (a *App) confirmCancelRun() tea.Cmd {
 	return nil
 }
 
-// rerunWorkflow triggers a workflow rerun
+// confirmBulkAction shows one confirmation dialog for applying action to the marked runs
+// that match applies. Matching runs are acted on concurrently.
+func (a *App) confirmBulkAction(name string, applies func(github.Run) bool,
+	action func(ctx context.Context, client github.Client, repo github.Repository, runID int64) error) tea.Cmd {
+	var runs []github.Run
+	for _, run := range a.runs.Marked() {
+		if applies(run) {
+			runs = append(runs, run)
+		}
+	}
+	if len(runs) == 0 {
+		return flashMessage(name+": no marked run applies", FlashDurationInfo)
+	}
+
+	client, repo := a.client, a.repo
+	a.showConfirm = true
+	a.confirmMsg = fmt.Sprintf("%s %d marked runs?", name, len(runs))
+	if skipped := a.runs.MarkedCount() - len(runs); skipped > 0 {
+		a.confirmMsg = fmt.Sprintf("%s %d marked runs (%d skipped)?", name, len(runs), skipped)
+	}
+	a.confirmFn = func() tea.Cmd {
+		return bulkRunAction(name, runs, func(ctx context.Context, runID int64) error {
+			return action(ctx, client, repo, runID)
+		})
+	}
+	return nil
+}
+
+// handleBulkAction reports the outcome of a bulk run action
+func (a *App) handleBulkAction(msg BulkActionMsg) tea.Cmd {
+	a.runs.ClearMarks()
+	if msg.Action == bulkCancel {
+		failed := make(map[int64]bool, len(msg.Failures))
+		for _, f := range msg.Failures {
+			failed[f.Run.ID] = true
+		}
+		now := time.Now()
+		for _, run := range msg.Runs {
+			if !failed[run.ID] {
+				a.recordCancelRequested(run.ID, now)
+			}
+		}
+	}
+
+	total := len(msg.Runs)
+	if len(msg.Failures) == 0 {
+		a.flashMsg = fmt.Sprintf("%s requested for %d runs", msg.Action, total)
+		return a.refreshCurrentWorkflow()
+	}
+
+	details := make([]string, 0, len(msg.Failures))
+	for i, f := range msg.Failures {
+		if i == MaxFailuresShown {
+			details = append(details, fmt.Sprintf("and %d more", len(msg.Failures)-i))
+			break
+		}
+		details = append(details, fmt.Sprintf("#%d: %v", f.Run.RunNumber, f.Err))
+	}
+	a.err = fmt.Errorf("%s: %d of %d runs failed (%s)", msg.Action, len(msg.Failures), total, strings.Join(details, "; "))
+	return a.refreshCurrentWorkflow()
+}
+
+// toggleVisual starts or ends visual range selection in the runs pane
+func (a *App) toggleVisual() {
+	if a.runs.InVisual() {
+		a.runs.EndVisual()
+	} else {
+		a.runs.StartVisual()
+	}
+}
+
+// logContentFocused returns true if the log content of the Logs tab has focus
+func (a *App) logContentFocused() bool {
+	return a.focusedPane == JobsPane && a.detailTab == LogsTab && !a.stepListFocused
+}
+
+// toggleLogSelection starts or ends selecting log lines
+func (a *App) toggleLogSelection() {
+	if a.logView.Selecting() {
+		a.logView.ClearSelection()
+	} else {
+		a.logView.StartSelection()
+	}
+}
+
+// copySelectedLogLines copies the selected log lines and ends the selection
+func (a *App) copySelectedLogLines() tea.Cmd {
+	item := yankItem{label: fmt.Sprintf("%d log lines", a.logView.SelectedCount()), value: a.logView.SelectedText()}
+	a.logView.ClearSelection()
+	return a.copyValue(item)
+}
+
+// confirmToggleWorkflow shows confirmation dialog for enabling or disabling a workflow
+func (a *App) confirmToggleWorkflow() tea.Cmd {
+	wf, ok := a.workflows.Selected()
+	if !ok {
+		return nil
+	}
+	enable := wf.IsDisabled()
+	a.showConfirm = true
+	if enable {
+		a.confirmMsg = "Enable " + wf.Name + "?"
+	} else {
+		a.confirmMsg = "Disable " + wf.Name + "?"
+	}
+	a.confirmFn = func() tea.Cmd {
+		return setWorkflowEnabled(a.client, a.repo, wf, enable)
+	}
+	return nil
+}
+
+// toggleHideDisabled shows or hides disabled workflows
+func (a *App) toggleHideDisabled() tea.Cmd {
+	a.hideDisabled = !a.hideDisabled
+	prev, _ := a.workflows.Selected()
+	a.setWorkflows(a.allWorkflows)
+
+	msg := "Showing disabled workflows"
+	if a.hideDisabled {
+		msg = "Hiding disabled workflows"
+	}
+	cmds := []tea.Cmd{flashMessage(msg, FlashDurationSuccess)}
+	if wf, ok := a.workflows.Selected(); ok && wf.ID != prev.ID {
+		cmds = append(cmds, a.onWorkflowSelectionChange())
+	}
+	return tea.Batch(cmds...)
+}
+
+// rerunWorkflow triggers a workflow rerun, or a rerun of every marked run
 func (a *App) rerunWorkflow() tea.Cmd {
+	return a.rerunWorkflowWith(nil)
+}
+
+// rerunWorkflowWith reruns the selected run, or every marked run, with opts
+func (a *App) rerunWorkflowWith(opts *github.RerunOpts) tea.Cmd {
+	if a.runs.MarkedCount() > 0 {
+		name := "Rerun"
+		if opts != nil && opts.EnableDebugLogging {
+			name = "Rerun with debug logging"
+		}
+		return a.confirmBulkAction(name, func(run github.Run) bool { return !run.IsRunning() },
+			func(ctx context.Context, client github.Client, repo github.Repository, runID int64) error {
+				return client.RerunWorkflow(ctx, repo, runID, opts)
+			})
+	}
 	run, ok := a.runs.Selected()
 	if !ok {
 		return nil
 	}
-	return rerunWorkflow(a.client, a.repo, run.ID)
+	return rerunWorkflow(a.client, a.repo, run.ID, opts)
 }
 
-// rerunFailedJobs reruns only failed jobs
+// rerunFailedJobs reruns only failed jobs, of the selected run or every marked failed run
 func (a *App) rerunFailedJobs() tea.Cmd {
+	if a.runs.MarkedCount() > 0 {
+		return a.confirmBulkAction("Rerun failed jobs", (github.Run).IsFailed,
+			func(ctx context.Context, client github.Client, repo github.Repository, runID int64) error {
+				return client.RerunFailedJobs(ctx, repo, runID, nil)
+			})
+	}
 	run, ok := a.runs.Selected()
 	if !ok || !run.IsFailed() {
 		return nil
 	}
-	return rerunFailedJobs(a.client, a.repo, run.ID)
+	return rerunFailedJobs(a.client, a.repo, run.ID, nil)
+}
+
+// rerunJob reruns the selected job with opts.
+// GitHub only reruns jobs that have completed.
+func (a *App) rerunJob(opts *github.RerunOpts) tea.Cmd {
+	job, ok := a.jobs.Selected()
+	if !ok {
+		return nil
+	}
+	if !job.IsCompleted() {
+		return flashMessage("Job is still running", FlashDurationInfo)
+	}
+	return rerunJob(a.client, a.repo, job.ID, opts)
+}
+
+// rerunWithDebug reruns the selected job in the jobs pane, or the selected
+// (or marked) runs in the runs pane, with debug logging enabled
+func (a *App) rerunWithDebug() tea.Cmd {
+	opts := &github.RerunOpts{EnableDebugLogging: true}
+	switch a.focusedPane {
+	case RunsPane:
+		return a.rerunWorkflowWith(opts)
+	case JobsPane:
+		return a.rerunJob(opts)
+	}
+	return nil
 }
 
 // triggerWorkflow triggers a workflow dispatch
@@ -53,19 +244,14 @@ func (a *App) triggerWorkflow() tea.Cmd {
 	return triggerWorkflow(a.client, a.repo, workflowFile, "main", nil)
 }
 
-// yankURL copies the selected run URL to clipboard
-func (a *App) yankURL() tea.Cmd {
-	run, ok := a.runs.Selected()
-	if !ok || run.URL == "" {
-		return nil
-	}
-
-	if err := a.clipboard.WriteAll(run.URL); err != nil {
+// copyURL copies url to clipboard
+func (a *App) copyURL(url string) tea.Cmd {
+	if err := a.clipboard.WriteAll(url); err != nil {
 		// Clipboard not available (e.g., headless environment)
 		// Show URL in flash message so user can copy manually
-		return flashMessage("URL: "+run.URL, FlashDurationInfo)
+		return flashMessage("URL: "+url, FlashDurationInfo)
 	}
-	return flashMessage("Copied: "+run.URL, FlashDurationSuccess)
+	return flashMessage("Copied: "+url, FlashDurationSuccess)
 }
 
 // refreshAll refreshes all data
@@ -1,7 +1,14 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/nnnkkk7/lazyactions/github"
 )
@@ -48,6 +55,244 @@ func TestApp_ConfirmCancelRun_Running(t *testing.T) {
 	}
 }
 
+func TestApp_ConfirmToggleWorkflow(t *testing.T) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock))
+	app.setWorkflows([]github.Workflow{
+		{ID: 1, Name: "CI", State: "active"},
+		{ID: 2, Name: "Nightly", State: "disabled_inactivity"},
+	})
+
+	app.confirmToggleWorkflow()
+	if !app.showConfirm || app.confirmMsg != "Disable CI?" {
+		t.Fatalf("confirm = %v %q, want disable prompt", app.showConfirm, app.confirmMsg)
+	}
+	msg, ok := app.confirmFn()().(WorkflowStateChangedMsg)
+	if !ok || msg.Enabled || msg.Workflow != "CI" {
+		t.Errorf("confirmFn() = %+v, want CI disabled", msg)
+	}
+	if calls := mock.DisableWorkflowCalls(); len(calls) != 1 || calls[0].WorkflowID != 1 {
+		t.Errorf("DisableWorkflow calls = %+v", calls)
+	}
+
+	app.workflows.SelectNext()
+	app.confirmToggleWorkflow()
+	if app.confirmMsg != "Enable Nightly?" {
+		t.Errorf("confirmMsg = %q, want enable prompt", app.confirmMsg)
+	}
+	app.confirmFn()()
+	if calls := mock.EnableWorkflowCalls(); len(calls) != 1 || calls[0].WorkflowID != 2 {
+		t.Errorf("EnableWorkflow calls = %+v", calls)
+	}
+}
+
+func TestApp_ToggleHideDisabled(t *testing.T) {
+	app := New(WithClient(newMockClient(nil)))
+	app.setWorkflows([]github.Workflow{
+		{ID: 1, Name: "Old", State: "disabled_manually"},
+		{ID: 2, Name: "CI", State: "active"},
+		{ID: 3, Name: "Stale", State: "disabled_inactivity"},
+		{ID: 4, Name: "Fork", State: "disabled_fork"},
+	})
+
+	if cmd := app.toggleHideDisabled(); cmd == nil {
+		t.Error("expected a flash message and a runs fetch")
+	}
+	var names []string
+	for _, wf := range app.workflows.Items() {
+		names = append(names, wf.Name)
+	}
+	if len(names) != 2 || names[0] != "CI" || names[1] != "Fork" {
+		t.Errorf("visible workflows = %v, want [CI Fork]", names)
+	}
+
+	// Reloaded workflows stay hidden
+	app.setWorkflows(app.allWorkflows)
+	if app.workflows.Len() != 2 {
+		t.Errorf("visible workflows after reload = %d, want 2", app.workflows.Len())
+	}
+
+	app.toggleHideDisabled()
+	if app.workflows.Len() != 4 {
+		t.Errorf("visible workflows = %d, want 4", app.workflows.Len())
+	}
+}
+
+func TestApp_BulkCancel_MarkedRuns(t *testing.T) {
+	mock := newMockClient(nil)
+	var inFlight, maxInFlight atomic.Int32
+	mock.CancelRunFunc = func(ctx context.Context, repo github.Repository, runID int64) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if runID == 3 {
+			return errors.New("already completed")
+		}
+		return nil
+	}
+	app := New(WithClient(mock))
+	app.focusedPane = RunsPane
+	runs := []github.Run{{ID: 100, RunNumber: 100, Status: "completed"}}
+	for i := 1; i <= 10; i++ {
+		runs = append(runs, github.Run{ID: int64(i), RunNumber: i, Status: "in_progress"})
+	}
+	app.runs.SetItems(runs)
+	app.runs.MarkAllFiltered()
+
+	app.confirmCancelRun()
+	if !app.showConfirm || app.confirmMsg != "Cancel 10 marked runs (1 skipped)?" {
+		t.Fatalf("confirm = %v %q, want one confirmation for the running marked runs", app.showConfirm, app.confirmMsg)
+	}
+	msg, ok := app.confirmFn()().(BulkActionMsg)
+	if !ok {
+		t.Fatal("confirmFn() should return BulkActionMsg")
+	}
+	if len(mock.CancelRunCalls()) != 10 {
+		t.Errorf("CancelRun called %d times, want 10", len(mock.CancelRunCalls()))
+	}
+	if got := maxInFlight.Load(); got > BulkActionConcurrency {
+		t.Errorf("%d concurrent requests, want at most %d", got, BulkActionConcurrency)
+	}
+	if len(msg.Runs) != 10 || len(msg.Failures) != 1 || msg.Failures[0].Run.ID != 3 {
+		t.Errorf("BulkActionMsg = %+v", msg)
+	}
+
+	app.Update(msg)
+	if app.err == nil || !strings.Contains(app.err.Error(), "1 of 10 runs failed (#3: already completed)") {
+		t.Errorf("err = %v, want per-run failure summary", app.err)
+	}
+	if app.runs.MarkedCount() != 0 {
+		t.Error("marks should be cleared after the action")
+	}
+	if _, ok := app.cancelRequested[3]; ok || len(app.cancelRequested) != 9 {
+		t.Errorf("cancelRequested = %v, want the 9 runs cancelled successfully", app.cancelRequested)
+	}
+}
+
+func TestApp_BulkRerun_SkipsRunningRuns(t *testing.T) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock))
+	app.runs.SetItems([]github.Run{
+		{ID: 1, Status: "completed", Conclusion: "failure"},
+		{ID: 2, Status: "in_progress"},
+		{ID: 3, Status: "completed", Conclusion: "success"},
+	})
+	app.runs.MarkAllFiltered()
+
+	app.rerunWorkflow()
+	if app.confirmMsg != "Rerun 2 marked runs (1 skipped)?" {
+		t.Fatalf("confirmMsg = %q", app.confirmMsg)
+	}
+	app.confirmFn()()
+	for _, call := range mock.RerunWorkflowCalls() {
+		if call.RunID == 2 {
+			t.Error("a running run must not be rerun")
+		}
+	}
+	if len(mock.RerunWorkflowCalls()) != 2 {
+		t.Errorf("RerunWorkflow called %d times, want 2", len(mock.RerunWorkflowCalls()))
+	}
+}
+
+func TestApp_BulkRerunFailed_NoneApplies(t *testing.T) {
+	app := New(WithClient(newMockClient(nil)))
+	app.runs.SetItems([]github.Run{{ID: 1, Status: "completed", Conclusion: "success"}})
+	app.runs.MarkAllFiltered()
+
+	if cmd := app.rerunFailedJobs(); cmd == nil {
+		t.Error("expected a flash message")
+	}
+	if app.showConfirm {
+		t.Error("no confirmation should be shown when no marked run failed")
+	}
+}
+
+func TestApp_MultiSelectKeys(t *testing.T) {
+	app := New(WithClient(newMockClient(nil)))
+	app.focusedPane = RunsPane
+	app.runs.SetItems([]github.Run{{ID: 1}, {ID: 2}, {ID: 3}})
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if app.runs.MarkedCount() != 1 {
+		t.Fatalf("space should mark the run, marked = %d", app.runs.MarkedCount())
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	if app.runs.MarkedCount() != 3 {
+		t.Errorf("visual range should mark 3 runs, marked = %d", app.runs.MarkedCount())
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.runs.InVisual() || app.runs.MarkedCount() != 3 {
+		t.Error("first Esc should end visual mode and keep the marks")
+	}
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.runs.MarkedCount() != 0 {
+		t.Error("second Esc should clear the marks")
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if app.runs.MarkedCount() != 3 {
+		t.Errorf("ctrl+a should mark all runs, marked = %d", app.runs.MarkedCount())
+	}
+}
+
+func TestApp_RerunJob(t *testing.T) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock))
+	app.focusedPane = JobsPane
+	app.jobs.SetItems([]github.Job{{ID: 5, Status: "in_progress"}, {ID: 6, Status: "completed", Conclusion: "failure"}})
+
+	// Running jobs cannot be rerun
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if len(mock.RerunJobCalls()) != 0 {
+		t.Error("running job should not be rerun")
+	}
+
+	app.jobs.SelectNext()
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if cmd == nil {
+		t.Fatal("expected a rerun command")
+	}
+	msg, ok := cmd().(JobRerunMsg)
+	if !ok || msg.JobID != 6 || !msg.Debug {
+		t.Errorf("cmd() = %+v, want debug rerun of job 6", msg)
+	}
+	calls := mock.RerunJobCalls()
+	if len(calls) != 1 || calls[0].Opts == nil || !calls[0].Opts.EnableDebugLogging {
+		t.Errorf("RerunJob calls = %+v, want debug logging", calls)
+	}
+
+	app.Update(msg)
+	if app.flashMsg != "Job rerun triggered (debug logging)" {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
+
+func TestApp_RerunWithDebug_Run(t *testing.T) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock))
+	app.focusedPane = RunsPane
+	app.runs.SetItems([]github.Run{{ID: 1, Status: "completed", Conclusion: "failure"}})
+
+	cmd := app.rerunWithDebug()
+	if msg, ok := cmd().(RunRerunMsg); !ok || !msg.Debug {
+		t.Errorf("cmd() = %+v, want debug rerun", msg)
+	}
+	calls := mock.RerunWorkflowCalls()
+	if len(calls) != 1 || !calls[0].Opts.EnableDebugLogging {
+		t.Errorf("RerunWorkflow calls = %+v, want debug logging", calls)
+	}
+}
+
 func TestApp_RerunWorkflow_NoSelection(t *testing.T) {
 	app := New()
 
@@ -2,6 +2,9 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -14,6 +17,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
 	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/notify"
 	"golang.org/x/term"
 )
 
@@ -32,6 +36,8 @@ type DetailTab int
 const (
 	LogsTab DetailTab = iota
 	InfoTab
+	WorkflowTab
+	GraphTab
 )
 
 // Layout constants
@@ -82,6 +88,20 @@ const (
 	FlashDurationSuccess = 2 * time.Second
 	// FlashDurationInfo is the flash message duration for info messages
 	FlashDurationInfo = 3 * time.Second
+	// PollInterval is the interval between background refreshes
+	PollInterval = 15 * time.Second
+	// ReconnectInitialDelay is the first wait before reconnecting while offline
+	ReconnectInitialDelay = 2 * time.Second
+	// ReconnectMaxDelay caps the reconnect backoff
+	ReconnectMaxDelay = time.Minute
+	// DefaultForceCancelAfter is how long a cancelled run may keep running before force-cancel is offered
+	DefaultForceCancelAfter = 2 * time.Minute
+	// BulkActionConcurrency limits the concurrent requests of a bulk run action
+	BulkActionConcurrency = 4
+	// ListRunsPageSize is the page size when listing every run of a workflow
+	ListRunsPageSize = 100
+	// MaxListedRuns caps the runs listed for a workflow; GitHub lists no more
+	MaxListedRuns = 1000
 )
 
 // Clipboard is an interface for clipboard operations
@@ -104,6 +124,10 @@ type App struct {
 	runs      *FilteredList[github.Run]
 	jobs      *FilteredList[github.Job]
 
+	// All loaded workflows, including those hidden by hideDisabled
+	allWorkflows []github.Workflow
+	hideDisabled bool
+
 	// UI state
 	focusedPane Pane
 	detailTab   DetailTab
@@ -131,9 +155,106 @@ type App struct {
 	// Flash message
 	flashMsg string
 
+	// Rate limit snapshot, refreshed after each API result
+	rateLimit github.RateLimit
+
+	// Pending retry reported by a fetch command
+	retryCh    chan RetryScheduledMsg
+	retryUntil time.Time
+	retryErr   error
+
+	// Offline mode: last data stays visible while reconnecting
+	offline          bool
+	lastUpdated      time.Time
+	reconnectDelay   time.Duration
+	reconnectAt      time.Time
+	reconnectPending bool
+
+	// In-flight request cancellation, one per pane
+	cancelWorkflows context.CancelFunc
+	cancelRuns      context.CancelFunc
+	cancelJobs      context.CancelFunc
+	cancelLogs      context.CancelFunc
+	cancelAllRuns   context.CancelFunc
+	cancelAttempt   context.CancelFunc
+	// Pending deployments of waiting runs, and of the run being reviewed
+	cancelDeployments context.CancelFunc
+	cancelReview      context.CancelFunc
+	// Per-pane requests of the Info tab
+	cancelWorkflowUsage context.CancelFunc
+	cancelRunUsage      context.CancelFunc
+	cancelDefinition    context.CancelFunc
+	cancelStats         context.CancelFunc
+	cancelFlaky         context.CancelFunc
+	cancelLogDiff       context.CancelFunc
+
+	// Terminal the program renders to, and the writer passing raw output to it
+	output   io.Writer
+	terminal *TerminalWriter
+
+	// Watched runs: notify when they complete
+	notifier    notify.Notifier
+	branch      string           // Local branch, for watching all of its runs
+	watchBranch bool             // Whether all runs on branch are watched
+	watchSince  time.Time        // When branch watching started
+	watchedRuns map[int64]bool   // Individually watched runs
+	runStatus   map[int64]string // Last seen status of watched runs
+	cancelWatch context.CancelFunc
+
+	// Deployments of runs waiting for approval
+	pendingDeployments map[int64][]github.PendingDeployment
+	reviewRunID        int64 // Run whose review dialog opens once its deployments load
+	review             *reviewDialog
+
+	// Billable usage, by workflow and run ID
+	costRates     github.CostRates
+	workflowUsage map[int64]usageState
+	runUsage      map[int64]usageState
+
+	// Workflow health statistics, by workflow ID
+	statsRuns     int
+	workflowStats map[int64]statsState
+	defaultBranch string
+
+	// Flaky jobs, by workflow ID
+	flaky     map[int64]flakyState
+	flakyList *flakyDialog
+
+	// Log diff between the selected job and the job of the same name in the base run
+	compareBase *github.Run
+	logDiff     *logDiffView
+
+	// Workflow definitions shown in the Workflow tab, by path and ref
+	local            LocalRepo
+	definitions      map[definitionKey]definitionState
+	definitionShown  definitionKey
+	definitionOffset int
+
+	// Lint results of the local workflow files
+	workflowLint lintState
+
+	// Earlier run attempt shown instead of the latest one
+	attemptRunID int64
+	attempt      int
+	attemptRun   *github.Run
+
+	// Cancel requests, so runs stuck while cancelling can be force-cancelled
+	forceCancelAfter time.Duration
+	cancelRequested  map[int64]time.Time
+
+	// Run deletion in progress (typed confirmation, progress, summary)
+	deleting *deleteDialog
+
+	// Save dialog of the shown logs
+	savingLogs *saveLogsDialog
+
+	// Yank menu of what can be copied from the selection
+	yanking *yankMenu
+
 	// Dependencies
 	client    github.Client
 	clipboard Clipboard
+	browser   Browser
 	keys      KeyMap
 
 	// Fullscreen log mode
@@ -166,6 +287,13 @@ func WithRepository(repo github.Repository) Option {
 	}
 }
 
+// WithBrowser sets the browser implementation
+func WithBrowser(b Browser) Option {
+	return func(a *App) {
+		a.browser = b
+	}
+}
+
 // WithClipboard sets the clipboard implementation
 func WithClipboard(cb Clipboard) Option {
 	return func(a *App) {
@@ -173,6 +301,58 @@ func WithClipboard(cb Clipboard) Option {
 	}
 }
 
+// WithNotifier sets the notifier used when watched runs complete
+func WithNotifier(n notify.Notifier) Option {
+	return func(a *App) {
+		a.notifier = n
+	}
+}
+
+// WithTerminalWriter connects w to the program once it runs, so that raw
+// output written to w (e.g., by terminal notifiers) reaches its terminal
+func WithTerminalWriter(w *TerminalWriter) Option {
+	return func(a *App) {
+		a.terminal = w
+	}
+}
+
+// WithBranch sets the local branch used by "watch my branch"
+func WithBranch(branch string) Option {
+	return func(a *App) {
+		a.branch = branch
+	}
+}
+
+// WithLocalRepo sets the local clone workflow definitions are read from
+// when their commit is available, compared with, and linted in
+func WithLocalRepo(local LocalRepo) Option {
+	return func(a *App) {
+		a.local = local
+	}
+}
+
+// WithForceCancelAfter sets how long a cancelled run may keep running
+// before force-cancel is offered
+func WithForceCancelAfter(d time.Duration) Option {
+	return func(a *App) {
+		a.forceCancelAfter = d
+	}
+}
+
+// WithCostRates sets the rates used to estimate the cost of billable time
+func WithCostRates(rates github.CostRates) Option {
+	return func(a *App) {
+		a.costRates = rates
+	}
+}
+
+// WithStatsRuns sets how many recent runs workflow statistics are computed from
+func WithStatsRuns(n int) Option {
+	return func(a *App) {
+		a.statsRuns = min(max(n, 1), MaxStatsRuns)
+	}
+}
+
 // New creates a new App instance
 func New(opts ...Option) *App {
 	ti := textinput.New()
@@ -201,8 +381,26 @@ func New(opts ...Option) *App {
 		keys:            DefaultKeyMap(),
 		selectedStepIdx: -1, // -1 means "All logs"
 		stepListFocused: true,
+		retryCh:         make(chan RetryScheduledMsg, 1),
+		watchedRuns:     make(map[int64]bool),
+		runStatus:       make(map[int64]string),
+
+		pendingDeployments: make(map[int64][]github.PendingDeployment),
+		forceCancelAfter:   DefaultForceCancelAfter,
+		costRates:          github.DefaultCostRates(),
+		workflowUsage:      make(map[int64]usageState),
+		runUsage:           make(map[int64]usageState),
+		statsRuns:          DefaultStatsRuns,
+		workflowStats:      make(map[int64]statsState),
+		flaky:              make(map[int64]flakyState),
+		definitions:        make(map[definitionKey]definitionState),
+		cancelRequested:    make(map[int64]time.Time),
 	}
 
+	a.workflows.SetKeyFunc(func(w github.Workflow) any { return w.ID })
+	a.runs.SetKeyFunc(func(r github.Run) any { return r.ID })
+	a.jobs.SetKeyFunc(func(j github.Job) any { return j.ID })
+
 	for _, opt := range opts {
 		opt(a)
 	}
@@ -211,6 +409,9 @@ func New(opts ...Option) *App {
 	if a.clipboard == nil {
 		a.clipboard = &realClipboard{}
 	}
+	if a.browser == nil {
+		a.browser = &realBrowser{}
+	}
 
 	return a
 }
@@ -220,6 +421,9 @@ func (a *App) Init() tea.Cmd {
 	return tea.Batch(
 		a.spinner.Tick,
 		a.fetchWorkflowsCmd(),
+		a.fetchLintCmd(),
+		a.schedulePoll(time.Now()),
+		waitForRetry(a.retryCh),
 	)
 }
 
@@ -227,6 +431,21 @@ func (a *App) Init() tea.Cmd {
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	// Every API result may have changed the rate limit
+	switch msg.(type) {
+	case WorkflowsLoadedMsg, RunsLoadedMsg, AllRunsLoadedMsg, JobsLoadedMsg, RunAttemptLoadedMsg, UsageLoadedMsg, WorkflowStatsLoadedMsg, FlakyJobsLoadedMsg, LogsLoadedMsg, LogDiffLoadedMsg, WorkflowDefinitionLoadedMsg,
+		WatchedRunsLoadedMsg, PendingDeploymentsLoadedMsg, DeploymentsReviewedMsg,
+		RunCancelledMsg, RunForceCancelledMsg, RunRerunMsg, RerunFailedJobsMsg, JobRerunMsg, RunDeletedMsg, BulkActionMsg,
+		WorkflowTriggeredMsg, WorkflowStateChangedMsg:
+		a.syncRateLimit()
+	}
+
+	// Only the results of requests made with retryNotifier end a pending retry
+	switch msg.(type) {
+	case WorkflowsLoadedMsg, RunsLoadedMsg, AllRunsLoadedMsg, JobsLoadedMsg, LogsLoadedMsg:
+		a.clearRetry()
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		cmd := a.handleKeyPress(msg)
@@ -246,40 +465,70 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.logView.SetSize(a.logPaneWidth(), a.logPaneHeight())
 
 	case WorkflowsLoadedMsg:
+		if isCancelled(msg.Err) {
+			break
+		}
 		a.loading = false
 		if msg.Err != nil {
-			a.err = msg.Err
+			cmds = append(cmds, a.handleLoadError(msg.Err))
 		} else {
-			a.workflows.SetItems(msg.Workflows)
+			a.markOnline(time.Now())
+			a.setWorkflows(msg.Workflows)
 			if a.workflows.Len() > 0 {
 				if wf, ok := a.workflows.Selected(); ok {
 					cmds = append(cmds, a.fetchRunsCmd(wf.ID))
 				}
 			}
+			cmds = append(cmds, a.fetchUsageCmd(), a.fetchStatsCmd())
 		}
 
 	case RunsLoadedMsg:
+		// Drop results for a workflow that is no longer selected
+		wf, ok := a.workflows.Selected()
+		if !ok || wf.ID != msg.WorkflowID || isCancelled(msg.Err) {
+			break
+		}
 		a.loading = false
 		if msg.Err != nil {
-			a.err = msg.Err
+			cmds = append(cmds, a.handleLoadError(msg.Err))
 		} else {
+			a.markOnline(time.Now())
+			prev, hadRun := a.runs.Selected()
 			a.runs.SetItems(msg.Runs)
-			if a.runs.Len() > 0 {
-				if run, ok := a.runs.Selected(); ok {
-					cmds = append(cmds, a.fetchJobsCmd(run.ID))
+			a.pruneCancelRequests(msg.Runs)
+			a.pruneRunUsage(msg.Runs)
+			cmds = append(cmds, a.fetchUsageCmd(), a.fetchDefinitionCmd())
+			cmds = append(cmds, a.observeRuns(msg.Runs, time.Now()))
+			cmds = append(cmds, a.fetchPendingDeploymentsCmd(msg.Runs))
+			if run, ok := a.runs.Selected(); ok {
+				if hadRun && run.ID != prev.ID {
+					// The selected run is gone: logs and attempts of the previous one no longer apply
+					cancelRequest(&a.cancelLogs)
+					a.resetAttempt()
 				}
+				cmds = append(cmds, a.fetchJobsCmd(run.ID))
 			}
 		}
 
 	case JobsLoadedMsg:
+		// Drop results for a run or attempt that is no longer selected
+		run, ok := a.runs.Selected()
+		if !ok || run.ID != msg.RunID || msg.Attempt != a.attemptFor(run.ID) || isCancelled(msg.Err) {
+			break
+		}
 		a.loading = false
 		if msg.Err != nil {
-			a.err = msg.Err
+			cmds = append(cmds, a.handleLoadError(msg.Err))
 		} else {
+			a.markOnline(time.Now())
+			prev, hadJob := a.jobs.Selected()
 			a.jobs.SetItems(msg.Jobs)
 			if job, ok := a.jobs.Selected(); ok {
-				// GitHub API only provides logs for completed jobs
-				if job.IsCompleted() && a.parsedLogs == nil {
+				if hadJob && job.ID != prev.ID {
+					// Another job is selected (e.g., of another run): drop the logs shown
+					cmds = append(cmds, a.onJobSelectionChange())
+				} else if job.IsCompleted() && a.parsedLogs == nil {
+					// GitHub API only provides logs for completed jobs
 					cmds = append(cmds, a.fetchLogsCmd(job.ID))
 				} else if !job.IsCompleted() {
 					a.logView.SetContent(jobStatusMessage(job))
@@ -287,11 +536,23 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case WorkflowStatsLoadedMsg:
+		cmds = append(cmds, a.handleWorkflowStatsLoaded(msg))
+
+	case FlakyJobsLoadedMsg:
+		cmds = append(cmds, a.handleFlakyJobsLoaded(msg))
+
+	case UsageLoadedMsg:
+		cmds = append(cmds, a.handleUsageLoaded(msg))
+
+	case RunAttemptLoadedMsg:
+		a.handleRunAttemptLoaded(msg)
+
 	case LogsLoadedMsg:
 		// Only update logs if they are for the currently selected job
 		// This prevents stale logs from overwriting newer ones
 		job, ok := a.jobs.Selected()
-		if !ok || job.ID != msg.JobID {
+		if !ok || job.ID != msg.JobID || isCancelled(msg.Err) {
 			break
 		}
 
@@ -309,11 +570,41 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.updateLogViewContent()
 		}
 
+	case LogDiffLoadedMsg:
+		a.handleLogDiffLoaded(msg)
+
+	case WorkflowDefinitionLoadedMsg:
+		cmds = append(cmds, a.handleWorkflowDefinitionLoaded(msg))
+
+	case LintLoadedMsg:
+		a.handleLintLoaded(msg)
+
+	case AllRunsLoadedMsg:
+		cmds = append(cmds, a.handleAllRunsLoaded(msg))
+
+	case TerminalOutputMsg:
+		a.handleTerminalOutput(msg)
+
+	case LogViewerClosedMsg:
+		cmds = append(cmds, a.handleLogViewerClosed(msg))
+
+	case LogsSavedMsg:
+		cmds = append(cmds, a.handleLogsSaved(msg))
+
 	case RunCancelledMsg:
 		if msg.Err != nil {
 			a.err = msg.Err
 		} else {
 			a.flashMsg = "Run cancelled"
+			a.recordCancelRequested(msg.RunID, time.Now())
+			cmds = append(cmds, a.refreshCurrentWorkflow())
+		}
+
+	case RunForceCancelledMsg:
+		if msg.Err != nil {
+			a.err = msg.Err
+		} else {
+			a.flashMsg = "Run force-cancelled"
 			cmds = append(cmds, a.refreshCurrentWorkflow())
 		}
 
@@ -321,7 +612,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Err != nil {
 			a.err = msg.Err
 		} else {
-			a.flashMsg = "Rerun triggered"
+			a.flashMsg = "Rerun triggered" + debugSuffix(msg.Debug)
 			cmds = append(cmds, a.refreshCurrentWorkflow())
 		}
 
@@ -329,10 +620,36 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Err != nil {
 			a.err = msg.Err
 		} else {
-			a.flashMsg = "Rerun failed jobs triggered"
+			a.flashMsg = "Rerun failed jobs triggered" + debugSuffix(msg.Debug)
 			cmds = append(cmds, a.refreshCurrentWorkflow())
 		}
 
+	case JobRerunMsg:
+		if msg.Err != nil {
+			a.err = msg.Err
+		} else {
+			a.flashMsg = "Job rerun triggered" + debugSuffix(msg.Debug)
+			cmds = append(cmds, a.refreshCurrentWorkflow())
+		}
+
+	case BulkActionMsg:
+		cmds = append(cmds, a.handleBulkAction(msg))
+
+	case RunDeletedMsg:
+		cmds = append(cmds, a.handleRunDeleted(msg))
+
+	case WorkflowStateChangedMsg:
+		if msg.Err != nil {
+			a.err = msg.Err
+		} else {
+			if msg.Enabled {
+				a.flashMsg = "Workflow enabled: " + msg.Workflow
+			} else {
+				a.flashMsg = "Workflow disabled: " + msg.Workflow
+			}
+			cmds = append(cmds, a.refreshAll())
+		}
+
 	case WorkflowTriggeredMsg:
 		if msg.Err != nil {
 			a.err = msg.Err
@@ -341,9 +658,35 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, a.refreshCurrentWorkflow())
 		}
 
+	case WatchedRunsLoadedMsg:
+		// Errors are not shown; the regular refresh reports connectivity problems
+		if !isCancelled(msg.Err) {
+			cmds = append(cmds, a.observeRuns(msg.Runs, time.Now()))
+		}
+
+	case PendingDeploymentsLoadedMsg:
+		cmds = append(cmds, a.handlePendingDeployments(msg))
+
+	case DeploymentsReviewedMsg:
+		cmds = append(cmds, a.handleDeploymentsReviewed(msg))
+
+	case FlashMsg:
+		a.flashMsg = msg.Message
+
 	case FlashClearMsg:
 		a.flashMsg = ""
 
+	case TickMsg:
+		cmds = append(cmds, a.handlePollTick(msg.Time))
+
+	case ReconnectMsg:
+		cmds = append(cmds, a.handleReconnect())
+
+	case RetryScheduledMsg:
+		a.retryUntil = time.Now().Add(msg.Wait)
+		a.retryErr = msg.Err
+		cmds = append(cmds, waitForRetry(a.retryCh))
+
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		a.spinner, cmd = a.spinner.Update(msg)
@@ -353,6 +696,30 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return a, tea.Batch(cmds...)
 }
 
+// debugSuffix marks flash messages of reruns with debug logging
+func debugSuffix(debug bool) string {
+	if debug {
+		return " (debug logging)"
+	}
+	return ""
+}
+
+// setWorkflows stores the loaded workflows and shows those not hidden by hideDisabled
+func (a *App) setWorkflows(workflows []github.Workflow) {
+	a.allWorkflows = workflows
+	if !a.hideDisabled {
+		a.workflows.SetItems(workflows)
+		return
+	}
+	visible := make([]github.Workflow, 0, len(workflows))
+	for _, wf := range workflows {
+		if wf.State != "disabled_manually" && wf.State != "disabled_inactivity" {
+			visible = append(visible, wf)
+		}
+	}
+	a.workflows.SetItems(visible)
+}
+
 // View implements tea.Model
 func (a *App) View() string {
 	if a.width == 0 || a.height == 0 {
@@ -363,6 +730,10 @@ func (a *App) View() string {
 		return a.renderFullscreenLog()
 	}
 
+	if a.logDiff != nil {
+		return a.renderLogDiff()
+	}
+
 	if a.showHelp {
 		return a.renderHelp()
 	}
@@ -371,6 +742,26 @@ func (a *App) View() string {
 		return a.renderConfirmDialog()
 	}
 
+	if a.review != nil {
+		return a.renderReviewDialog()
+	}
+
+	if a.deleting != nil {
+		return a.renderDeleteDialog()
+	}
+
+	if a.flakyList != nil {
+		return a.renderFlakyDialog()
+	}
+
+	if a.savingLogs != nil {
+		return a.renderSaveLogsDialog()
+	}
+
+	if a.yanking != nil {
+		return a.renderYankMenu()
+	}
+
 	// Calculate dimensions using helper
 	totalHeight, panelHeight := a.panelLayout()
 
@@ -431,33 +822,59 @@ func (a *App) View() string {
 }
 
 // Command generators
+// Each pane has at most one request in flight; starting a new one cancels the previous.
 func (a *App) fetchWorkflowsCmd() tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
 	a.loading = true
-	return fetchWorkflows(a.client, a.repo)
+	ctx := newRequestContext(&a.cancelWorkflows)
+	return fetchWorkflows(ctx, a.client, a.repo, a.retryNotifier())
 }
 
 func (a *App) fetchRunsCmd(workflowID int64) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
-	return fetchRuns(a.client, a.repo, workflowID)
+	ctx := newRequestContext(&a.cancelRuns)
+	return fetchRuns(ctx, a.client, a.repo, workflowID, a.retryNotifier())
 }
 
 func (a *App) fetchJobsCmd(runID int64) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
-	return fetchJobs(a.client, a.repo, runID)
+	ctx := newRequestContext(&a.cancelJobs)
+	return fetchJobs(ctx, a.client, a.repo, runID, a.attemptFor(runID), a.retryNotifier())
 }
 
 func (a *App) fetchLogsCmd(jobID int64) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
-	return fetchLogs(a.client, a.repo, jobID)
+	ctx := newRequestContext(&a.cancelLogs)
+	return fetchLogs(ctx, a.client, a.repo, jobID, a.retryNotifier())
+}
+
+// newRequestContext cancels the request tracked by cancel and returns a context for the next one
+func newRequestContext(cancel *context.CancelFunc) context.Context {
+	cancelRequest(cancel)
+	ctx, c := context.WithCancel(context.Background())
+	*cancel = c
+	return ctx
+}
+
+// cancelRequest cancels the request tracked by cancel, if any
+func cancelRequest(cancel *context.CancelFunc) {
+	if *cancel != nil {
+		(*cancel)()
+		*cancel = nil
+	}
+}
+
+// isCancelled returns true if err comes from a request that was cancelled on purpose
+func isCancelled(err error) bool {
+	return errors.Is(err, context.Canceled)
 }
 
 // formatRunNumber formats a run ID for display
@@ -466,17 +883,17 @@ func formatRunNumber(id int64) string {
 }
 
 // Run starts the TUI application
-func Run(client github.Client, repo github.Repository) error {
+func Run(client github.Client, repo github.Repository, opts ...Option) error {
 	// Display startup banner
 	PrintBanner()
 
-	app := New(
+	app := New(append([]Option{
 		WithClient(client),
 		WithRepository(repo),
-	)
+	}, opts...)...)
 
 	// Build program options
-	opts := []tea.ProgramOption{
+	programOpts := []tea.ProgramOption{
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	}
@@ -487,24 +904,32 @@ func Run(client github.Client, repo github.Repository) error {
 	stdoutIsTTY := term.IsTerminal(int(os.Stdout.Fd()))
 
 	var ttyFile *os.File
+	app.output = os.Stdout
 	if !stdinIsTTY || !stdoutIsTTY {
 		var err error
 		ttyFile, err = os.OpenFile("/dev/tty", os.O_RDWR, 0)
 		if err == nil {
 			// Redirect bubbletea to use the real TTY
 			if !stdinIsTTY {
-				opts = append(opts, tea.WithInput(ttyFile))
+				programOpts = append(programOpts, tea.WithInput(ttyFile))
 			}
 			if !stdoutIsTTY {
-				opts = append(opts, tea.WithOutput(ttyFile))
+				programOpts = append(programOpts, tea.WithOutput(ttyFile))
+				app.output = ttyFile
 			}
 			// Force TrueColor renderer on the TTY for proper color support
 			lipgloss.SetDefaultRenderer(lipgloss.NewRenderer(ttyFile, termenv.WithProfile(termenv.TrueColor)))
 		}
 	}
 
-	p := tea.NewProgram(app, opts...)
+	p := tea.NewProgram(app, programOpts...)
+	if app.terminal != nil {
+		app.terminal.attach(p)
+	}
 	_, err := p.Run()
+	if app.terminal != nil {
+		app.terminal.attach(nil)
+	}
 
 	// Clean up TTY file if we opened it
 	if ttyFile != nil {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -170,11 +171,12 @@ func TestApp_Update_WorkflowsLoadedMsg_WithError(t *testing.T) {
 
 func TestApp_Update_RunsLoadedMsg(t *testing.T) {
 	app := New()
+	app.workflows.SetItems([]github.Workflow{{ID: 10, Name: "CI"}})
 
 	runs := []github.Run{
 		{ID: 1, Name: "Run 1"},
 	}
-	msg := RunsLoadedMsg{Runs: runs}
+	msg := RunsLoadedMsg{WorkflowID: 10, Runs: runs}
 	model, _ := app.Update(msg)
 	updated := model.(*App)
 
@@ -185,11 +187,12 @@ func TestApp_Update_RunsLoadedMsg(t *testing.T) {
 
 func TestApp_Update_JobsLoadedMsg(t *testing.T) {
 	app := New()
+	app.runs.SetItems([]github.Run{{ID: 100, Name: "CI"}})
 
 	jobs := []github.Job{
 		{ID: 1, Name: "build", Status: "completed"},
 	}
-	msg := JobsLoadedMsg{Jobs: jobs}
+	msg := JobsLoadedMsg{RunID: 100, Jobs: jobs}
 	model, _ := app.Update(msg)
 	updated := model.(*App)
 
@@ -200,11 +203,12 @@ func TestApp_Update_JobsLoadedMsg(t *testing.T) {
 
 func TestApp_Update_JobsLoadedMsg_QueuedJob(t *testing.T) {
 	app := New()
+	app.runs.SetItems([]github.Run{{ID: 100, Name: "CI"}})
 
 	jobs := []github.Job{
 		{ID: 1, Name: "build", Status: "queued"},
 	}
-	msg := JobsLoadedMsg{Jobs: jobs}
+	msg := JobsLoadedMsg{RunID: 100, Jobs: jobs}
 	model, _ := app.Update(msg)
 	updated := model.(*App)
 
@@ -219,11 +223,12 @@ func TestApp_Update_JobsLoadedMsg_QueuedJob(t *testing.T) {
 
 func TestApp_Update_JobsLoadedMsg_InProgressJob(t *testing.T) {
 	app := New()
+	app.runs.SetItems([]github.Run{{ID: 100, Name: "CI"}})
 
 	jobs := []github.Job{
 		{ID: 1, Name: "build", Status: "in_progress"},
 	}
-	msg := JobsLoadedMsg{Jobs: jobs}
+	msg := JobsLoadedMsg{RunID: 100, Jobs: jobs}
 	model, _ := app.Update(msg)
 	updated := model.(*App)
 
@@ -236,6 +241,89 @@ func TestApp_Update_JobsLoadedMsg_InProgressJob(t *testing.T) {
 	}
 }
 
+func TestApp_Update_RunsLoadedMsg_DropsStale(t *testing.T) {
+	app := New()
+	app.workflows.SetItems([]github.Workflow{{ID: 10, Name: "CI"}, {ID: 20, Name: "Deploy"}})
+	app.loading = true
+
+	// Runs fetched for a workflow that is no longer selected
+	app.Update(RunsLoadedMsg{WorkflowID: 20, Runs: []github.Run{{ID: 1}}})
+
+	if app.runs.Len() != 0 {
+		t.Errorf("runs.Len() = %d, stale runs should be dropped", app.runs.Len())
+	}
+	if !app.loading {
+		t.Error("loading should stay set until the current request finishes")
+	}
+}
+
+func TestApp_Update_JobsLoadedMsg_DropsStale(t *testing.T) {
+	app := New()
+	app.runs.SetItems([]github.Run{{ID: 100}, {ID: 101}})
+
+	app.Update(JobsLoadedMsg{RunID: 101, Jobs: []github.Job{{ID: 1, Name: "build"}}})
+
+	if app.jobs.Len() != 0 {
+		t.Errorf("jobs.Len() = %d, stale jobs should be dropped", app.jobs.Len())
+	}
+}
+
+func TestApp_Update_DropsCancelled(t *testing.T) {
+	app := New()
+	app.workflows.SetItems([]github.Workflow{{ID: 10, Name: "CI"}})
+
+	app.Update(RunsLoadedMsg{WorkflowID: 10, Err: context.Canceled})
+
+	if app.err != nil {
+		t.Errorf("err = %v, cancelled requests should not surface errors", app.err)
+	}
+}
+
+func TestApp_SelectionChangeCancelsInFlight(t *testing.T) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock))
+	app.workflows.SetItems([]github.Workflow{{ID: 10}, {ID: 20}})
+	app.runs.SetItems([]github.Run{{ID: 100}, {ID: 101}})
+
+	app.fetchJobsCmd(100)
+	jobsCancel := app.cancelJobs
+	app.fetchLogsCmd(1)
+	logsCancel := app.cancelLogs
+	if jobsCancel == nil || logsCancel == nil {
+		t.Fatal("in-flight requests should be tracked")
+	}
+
+	// Changing the workflow cancels jobs and logs of the old one
+	app.workflows.SelectNext()
+	app.onWorkflowSelectionChange()
+
+	if app.cancelJobs != nil || app.cancelLogs != nil {
+		t.Error("downstream requests should be cancelled on workflow change")
+	}
+}
+
+func TestNewRequestContext(t *testing.T) {
+	var cancel context.CancelFunc
+
+	first := newRequestContext(&cancel)
+	second := newRequestContext(&cancel)
+
+	if first.Err() == nil {
+		t.Error("starting a new request should cancel the previous one")
+	}
+	if second.Err() != nil {
+		t.Error("the new request should not be cancelled")
+	}
+
+	cancelRequest(&cancel)
+	if second.Err() == nil {
+		t.Error("cancelRequest should cancel the tracked request")
+	}
+	if cancel != nil {
+		t.Error("cancelRequest should clear the tracked cancel func")
+	}
+}
+
 func TestApp_Update_LogsLoadedMsg(t *testing.T) {
 	app := New()
 	app.jobs.SetItems([]github.Job{{ID: 1, Name: "build", Status: "completed"}})
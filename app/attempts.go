@@ -0,0 +1,101 @@
+package app
+
+import (
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Run attempts - ListJobs only returns the jobs of the latest attempt,
+// so earlier attempts of a rerun run are fetched explicitly
+
+// attemptFor returns the earlier attempt shown for runID, or 0 for the latest attempt
+func (a *App) attemptFor(runID int64) int {
+	if a.attemptRunID == runID {
+		return a.attempt
+	}
+	return 0
+}
+
+// resetAttempt goes back to showing the latest attempt of every run
+func (a *App) resetAttempt() {
+	cancelRequest(&a.cancelAttempt)
+	a.attemptRunID = 0
+	a.attempt = 0
+	a.attemptRun = nil
+}
+
+// shownAttempt returns the attempt of run currently shown (e.g., 1 of 2)
+func (a *App) shownAttempt(run github.Run) int {
+	if attempt := a.attemptFor(run.ID); attempt > 0 {
+		return attempt
+	}
+	return run.Attempt
+}
+
+// cycleAttempt shows the previous (delta -1) or next (delta +1) attempt of the selected run
+func (a *App) cycleAttempt(delta int) tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok {
+		return nil
+	}
+	if run.Attempt <= 1 {
+		return flashMessage("Run #"+strconv.Itoa(run.RunNumber)+" has a single attempt", FlashDurationInfo)
+	}
+	next := a.shownAttempt(run) + delta
+	if next < 1 || next > run.Attempt {
+		return nil
+	}
+
+	a.resetAttempt()
+	if next < run.Attempt {
+		a.attemptRunID = run.ID
+		a.attempt = next
+	}
+
+	// Jobs and logs of the previous attempt are no longer valid
+	cancelRequest(&a.cancelLogs)
+	a.jobs.SetItems(nil)
+	a.parsedLogs = nil
+	a.selectedStepIdx = -1
+	a.logView.SetContent("Loading...")
+	a.loading = true
+
+	cmds := []tea.Cmd{a.fetchJobsCmd(run.ID)}
+	if a.attempt > 0 && a.client != nil {
+		ctx := newRequestContext(&a.cancelAttempt)
+		cmds = append(cmds, fetchRunAttempt(ctx, a.client, a.repo, run.ID, a.attempt))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleRunAttemptLoaded keeps the details of the earlier attempt being shown
+func (a *App) handleRunAttemptLoaded(msg RunAttemptLoadedMsg) {
+	if msg.Attempt != a.attemptFor(msg.RunID) || isCancelled(msg.Err) {
+		return
+	}
+	if msg.Err != nil {
+		a.err = msg.Err
+		return
+	}
+	a.attemptRun = msg.Run
+}
+
+// attemptDetails returns the run as it was in the attempt shown:
+// the earlier attempt once loaded, otherwise run itself
+func (a *App) attemptDetails(run github.Run) github.Run {
+	if a.attemptFor(run.ID) > 0 && a.attemptRun != nil && a.attemptRun.ID == run.ID {
+		return *a.attemptRun
+	}
+	return run
+}
+
+// attemptLabel describes the attempt shown for run (e.g., "attempt 1/2"),
+// or returns "" for runs that were never rerun
+func (a *App) attemptLabel(run github.Run) string {
+	if run.Attempt <= 1 {
+		return ""
+	}
+	return "attempt " + strconv.Itoa(a.shownAttempt(run)) + "/" + strconv.Itoa(run.Attempt)
+}
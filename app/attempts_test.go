@@ -0,0 +1,102 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newAttemptsApp() (*App, *github.MockClient) {
+	mock := newMockClient(&mockClientState{
+		jobs:        []github.Job{{ID: 200, Name: "test", Status: "completed", Conclusion: "success"}},
+		attempts:    map[int]github.Run{1: {ID: 7, RunNumber: 3, Attempt: 1, Status: "completed", Conclusion: "failure"}},
+		attemptJobs: map[int][]github.Job{1: {{ID: 100, Name: "test", Status: "completed", Conclusion: "failure"}}},
+	})
+	app := New(WithClient(mock), WithRepository(github.Repository{Owner: "owner", Name: "repo"}))
+	app.width = 100
+	app.height = 40
+	app.focusedPane = RunsPane
+	app.runs.SetItems([]github.Run{{ID: 7, RunNumber: 3, Attempt: 2, Status: "completed", Conclusion: "success"}})
+	return app, mock
+}
+
+func TestApp_CycleAttempt(t *testing.T) {
+	app, mock := newAttemptsApp()
+
+	// Latest attempt: nothing newer
+	if cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}}); cmd != nil {
+		t.Error("] on the latest attempt should do nothing")
+	}
+
+	for _, msg := range runCmds(app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})) {
+		app.Update(msg)
+	}
+	if calls := mock.ListJobsForAttemptCalls(); len(calls) != 1 || calls[0].RunID != 7 || calls[0].Attempt != 1 {
+		t.Fatalf("ListJobsForAttempt calls = %v, want run 7 attempt 1", calls)
+	}
+	if job, ok := app.jobs.Selected(); !ok || job.ID != 100 {
+		t.Errorf("selected job = %+v, want the job of attempt 1", job)
+	}
+	app.detailTab = InfoTab
+	view := app.View()
+	for _, want := range []string{"Jobs (attempt 1/2)", "Attempt: 1 of 2", "Result: failure"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should contain %q:\n%s", want, view)
+		}
+	}
+
+	// Back to the latest attempt
+	for _, msg := range runCmds(app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})) {
+		app.Update(msg)
+	}
+	if job, ok := app.jobs.Selected(); !ok || job.ID != 200 {
+		t.Errorf("selected job = %+v, want the job of the latest attempt", job)
+	}
+	if app.attemptFor(7) != 0 {
+		t.Error("attempt should be reset to the latest")
+	}
+}
+
+func TestApp_CycleAttempt_CancelsAttemptRequest(t *testing.T) {
+	app, _ := newAttemptsApp()
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})
+	if app.cancelAttempt == nil {
+		t.Fatal("the attempt request should be tracked")
+	}
+
+	// Going back to the latest attempt cancels the request of the earlier one
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	if app.cancelAttempt != nil {
+		t.Error("the attempt request should be cancelled")
+	}
+}
+
+func TestApp_CycleAttempt_SingleAttempt(t *testing.T) {
+	app, mock := newAttemptsApp()
+	app.runs.SetItems([]github.Run{{ID: 8, RunNumber: 4, Attempt: 1}})
+
+	msgs := runCmds(app.cycleAttempt(-1))
+	if len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "single attempt") {
+		t.Errorf("msgs = %v, want a flash message", msgs)
+	}
+	if len(mock.ListJobsForAttemptCalls()) != 0 {
+		t.Error("no attempt should be fetched")
+	}
+}
+
+func TestApp_JobsLoaded_DropsOtherAttempt(t *testing.T) {
+	app, _ := newAttemptsApp()
+	app.attemptRunID, app.attempt = 7, 1
+
+	app.Update(JobsLoadedMsg{RunID: 7, Jobs: []github.Job{{ID: 200}}})
+	if app.jobs.Len() != 0 {
+		t.Error("jobs of the latest attempt should be dropped while attempt 1 is shown")
+	}
+	app.Update(JobsLoadedMsg{RunID: 7, Attempt: 1, Jobs: []github.Job{{ID: 100}}})
+	if app.jobs.Len() != 1 {
+		t.Error("jobs of the shown attempt should be kept")
+	}
+}
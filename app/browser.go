@@ -0,0 +1,155 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// githubURL is the base URL of pages on GitHub
+const githubURL = "https://github.com"
+
+// Browser is an interface for opening URLs in a web browser
+type Browser interface {
+	Open(url string) error
+}
+
+// realBrowser implements Browser with $BROWSER or the platform's opener
+type realBrowser struct{}
+
+func (b *realBrowser) Open(url string) error {
+	name, args := browserCommand(url, os.Getenv("BROWSER"), runtime.GOOS)
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+// browserCommand returns the command opening url: the first browser of
+// $BROWSER (with %s replaced by the URL, if present), or the platform's opener
+func browserCommand(url, browser, goos string) (string, []string) {
+	if first, _, _ := strings.Cut(browser, string(os.PathListSeparator)); strings.TrimSpace(first) != "" {
+		fields := strings.Fields(first)
+		if strings.Contains(first, "%s") {
+			for i, f := range fields {
+				fields[i] = strings.ReplaceAll(f, "%s", url)
+			}
+			return fields[0], fields[1:]
+		}
+		return fields[0], append(fields[1:], url)
+	}
+	switch goos {
+	case "darwin":
+		return "open", []string{url}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}
+	}
+	return "xdg-open", []string{url}
+}
+
+// workflowFileURL returns the page of a workflow file on a branch
+func workflowFileURL(repo github.Repository, branch, path string) string {
+	return fmt.Sprintf("%s/%s/%s/blob/%s/%s", githubURL, repo.Owner, repo.Name, branch, path)
+}
+
+// pullRequestURL returns the page of a pull request
+func pullRequestURL(repo github.Repository, number int) string {
+	return fmt.Sprintf("%s/%s/%s/pull/%d", githubURL, repo.Owner, repo.Name, number)
+}
+
+// stepURL returns the anchor of a line of a step on a job page
+func stepURL(jobURL string, step, line int) string {
+	return fmt.Sprintf("%s#step:%d:%d", jobURL, step, line)
+}
+
+// browserURL returns the page of what is selected in the focused pane:
+// the workflow file, the run (at the shown attempt), or the job, at the
+// selected step when the Logs tab shows one
+func (a *App) browserURL() string {
+	switch a.focusedPane {
+	case WorkflowsPane:
+		wf, ok := a.workflows.Selected()
+		if !ok || wf.Path == "" {
+			return ""
+		}
+		branch := a.defaultBranch
+		if branch == "" {
+			branch = "HEAD"
+		}
+		return workflowFileURL(a.repo, branch, wf.Path)
+
+	case RunsPane:
+		run, ok := a.runs.Selected()
+		if !ok || run.URL == "" {
+			return ""
+		}
+		if attempt := a.shownAttempt(run); attempt < run.Attempt {
+			return fmt.Sprintf("%s/attempts/%d", run.URL, attempt)
+		}
+		return run.URL
+
+	case JobsPane:
+		job, ok := a.jobs.Selected()
+		if !ok || job.URL == "" {
+			return ""
+		}
+		if step := a.selectedStepNumber(job); step > 0 && a.detailTab == LogsTab {
+			return stepURL(job.URL, step, 1)
+		}
+		return job.URL
+	}
+	return ""
+}
+
+// selectedStepNumber returns the number of the job step whose logs are shown,
+// or 0 when all logs are shown or the step is not one of the job's
+func (a *App) selectedStepNumber(job github.Job) int {
+	if a.parsedLogs == nil || a.selectedStepIdx < 0 || a.selectedStepIdx >= len(a.parsedLogs.Steps) {
+		return 0
+	}
+	name := a.parsedLogs.Steps[a.selectedStepIdx].Name
+	for _, step := range job.Steps {
+		if step.Name == name || "Run "+step.Name == name {
+			return step.Number
+		}
+	}
+	return 0
+}
+
+// openInBrowser opens the page of what is selected in the focused pane
+func (a *App) openInBrowser() tea.Cmd {
+	url := a.browserURL()
+	if url == "" {
+		return nil
+	}
+	return a.openURL(url)
+}
+
+// openPullRequest opens the pull request of the selected run
+func (a *App) openPullRequest() tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok {
+		return nil
+	}
+	if len(run.PullRequests) == 0 {
+		return flashMessage(fmt.Sprintf("Run #%d has no pull request", run.RunNumber), FlashDurationInfo)
+	}
+	return a.openURL(pullRequestURL(a.repo, run.PullRequests[0]))
+}
+
+// openURL opens url in the browser
+func (a *App) openURL(url string) tea.Cmd {
+	if err := a.browser.Open(url); err != nil {
+		// No browser available (e.g., over SSH)
+		// Show URL in flash message so user can open it manually
+		return flashMessage("URL: "+url, FlashDurationInfo)
+	}
+	return flashMessage("Opened: "+url, FlashDurationSuccess)
+}
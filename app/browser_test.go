@@ -0,0 +1,128 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// mockBrowser records the URLs opened
+type mockBrowser struct {
+	opened []string
+	err    error
+}
+
+func (m *mockBrowser) Open(url string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.opened = append(m.opened, url)
+	return nil
+}
+
+func newBrowserTestApp(b Browser) *App {
+	app := New(WithRepository(github.Repository{Owner: "owner", Name: "repo"}), WithBrowser(b))
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI", Path: ".github/workflows/ci.yml"}})
+	app.runs.SetItems([]github.Run{{ID: 10, RunNumber: 7, Attempt: 2, URL: "https://github.com/owner/repo/actions/runs/10", PullRequests: []int{42}}})
+	app.jobs.SetItems([]github.Job{{
+		ID:    100,
+		Name:  "test",
+		URL:   "https://github.com/owner/repo/actions/runs/10/job/100",
+		Steps: []github.Step{{Name: "Set up job", Number: 1}, {Name: "make test", Number: 3}},
+	}})
+	return app
+}
+
+func TestBrowserCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		browser  string
+		goos     string
+		wantName string
+		wantArgs []string
+	}{
+		{"linux", "", "linux", "xdg-open", []string{"https://x"}},
+		{"macOS", "", "darwin", "open", []string{"https://x"}},
+		{"windows", "", "windows", "rundll32", []string{"url.dll,FileProtocolHandler", "https://x"}},
+		{"$BROWSER", "firefox --new-tab", "linux", "firefox", []string{"--new-tab", "https://x"}},
+		{"$BROWSER with %s", "lynx -dump %s", "linux", "lynx", []string{"-dump", "https://x"}},
+		{"$BROWSER list", "w3m:firefox", "linux", "w3m", []string{"https://x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, args := browserCommand("https://x", tt.browser, tt.goos)
+			if name != tt.wantName || strings.Join(args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("browserCommand() = %s %v, want %s %v", name, args, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestApp_OpenInBrowser(t *testing.T) {
+	b := &mockBrowser{}
+	app := newBrowserTestApp(b)
+	open := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}}
+
+	app.focusedPane = WorkflowsPane
+	app.handleKeyPress(open)
+	app.defaultBranch = "main"
+	app.handleKeyPress(open)
+
+	app.focusedPane = RunsPane
+	app.handleKeyPress(open)
+	app.attemptRunID, app.attempt = 10, 1
+	app.handleKeyPress(open)
+
+	app.focusedPane = JobsPane
+	app.handleKeyPress(open)
+	app.detailTab = LogsTab
+	app.parsedLogs = &ParsedLogs{Steps: []StepLog{{Name: "Set up job"}, {Name: "Run make test"}}}
+	app.selectedStepIdx = 1
+	app.handleKeyPress(open)
+
+	want := []string{
+		"https://github.com/owner/repo/blob/HEAD/.github/workflows/ci.yml",
+		"https://github.com/owner/repo/blob/main/.github/workflows/ci.yml",
+		"https://github.com/owner/repo/actions/runs/10",
+		"https://github.com/owner/repo/actions/runs/10/attempts/1",
+		"https://github.com/owner/repo/actions/runs/10/job/100",
+		"https://github.com/owner/repo/actions/runs/10/job/100#step:3:1",
+	}
+	if strings.Join(b.opened, "\n") != strings.Join(want, "\n") {
+		t.Errorf("opened =\n%s\nwant\n%s", strings.Join(b.opened, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestApp_OpenPullRequest(t *testing.T) {
+	b := &mockBrowser{}
+	app := newBrowserTestApp(b)
+
+	// From any pane
+	app.focusedPane = JobsPane
+	msgs := runCmds(app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}}))
+	if len(b.opened) != 1 || b.opened[0] != "https://github.com/owner/repo/pull/42" {
+		t.Errorf("opened = %v, want the pull request", b.opened)
+	}
+	if len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "Opened: ") {
+		t.Errorf("msgs = %v, want a flash message", msgs)
+	}
+
+	app.runs.SetItems([]github.Run{{ID: 11, RunNumber: 8}})
+	msgs = runCmds(app.openPullRequest())
+	if len(b.opened) != 1 || len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "Run #8 has no pull request") {
+		t.Errorf("msgs = %v, want a flash message instead of opening", msgs)
+	}
+}
+
+func TestApp_OpenInBrowser_Unavailable(t *testing.T) {
+	app := newBrowserTestApp(&mockBrowser{err: errors.New("no browser")})
+	app.focusedPane = RunsPane
+
+	msgs := runCmds(app.openInBrowser())
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "URL: https://github.com/owner/repo/actions/runs/10" {
+		t.Errorf("msgs = %v, want the URL shown instead", msgs)
+	}
+}
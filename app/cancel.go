@@ -0,0 +1,77 @@
+package app
+
+import (
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Force-cancel - runs with always() steps may keep running long after a cancel
+// request, so force-cancel is offered once forceCancelAfter has passed
+
+// recordCancelRequested remembers when cancelling runID was requested
+func (a *App) recordCancelRequested(runID int64, now time.Time) {
+	if _, ok := a.cancelRequested[runID]; !ok {
+		a.cancelRequested[runID] = now
+	}
+}
+
+// pruneCancelRequests forgets cancel requests of runs that have completed
+func (a *App) pruneCancelRequests(runs []github.Run) {
+	for _, run := range runs {
+		if !run.IsRunning() {
+			delete(a.cancelRequested, run.ID)
+		}
+	}
+}
+
+// cancelState reports how long ago cancelling run was requested and whether it is stuck.
+// ok is false if no cancel was requested or the run has completed.
+func (a *App) cancelState(run github.Run, now time.Time) (elapsed time.Duration, stuck, ok bool) {
+	requested, ok := a.cancelRequested[run.ID]
+	if !ok || !run.IsRunning() {
+		return 0, false, false
+	}
+	elapsed = now.Sub(requested)
+	return elapsed, elapsed >= a.forceCancelAfter, true
+}
+
+// cancelBadge describes a run that is being cancelled (e.g., "cancelling 40s"),
+// or returns "" if no cancel is in progress
+func (a *App) cancelBadge(run github.Run, now time.Time) string {
+	elapsed, stuck, ok := a.cancelState(run, now)
+	if !ok {
+		return ""
+	}
+	if stuck {
+		return "stuck cancelling " + formatCountdown(elapsed) + " [C]"
+	}
+	return "cancelling " + formatCountdown(elapsed)
+}
+
+// confirmForceCancelRun shows confirmation dialog for force-cancelling the
+// selected run once a normal cancel has not completed it in time
+func (a *App) confirmForceCancelRun() tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok || !run.IsRunning() {
+		return nil
+	}
+
+	elapsed, stuck, ok := a.cancelState(run, time.Now())
+	if !ok {
+		return flashMessage("Cancel the run first with [c]", FlashDurationInfo)
+	}
+	if !stuck {
+		wait := formatCountdown(a.forceCancelAfter - elapsed)
+		return flashMessage("Still cancelling; force-cancel available in "+wait, FlashDurationInfo)
+	}
+
+	a.showConfirm = true
+	a.confirmMsg = "Force-cancel #" + strconv.Itoa(run.RunNumber) + "? always() steps will not run."
+	a.confirmFn = func() tea.Cmd {
+		return forceCancelRun(a.client, a.repo, run.ID)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newCancelApp() (*App, *github.MockClient) {
+	mock := newMockClient(nil)
+	app := New(WithClient(mock), WithRepository(github.Repository{Owner: "owner", Name: "repo"}),
+		WithForceCancelAfter(time.Minute))
+	app.width = 100
+	app.height = 40
+	app.focusedPane = RunsPane
+	app.runs.SetItems([]github.Run{{ID: 7, RunNumber: 3, Status: "in_progress"}})
+	return app, mock
+}
+
+func TestApp_CancelBadge(t *testing.T) {
+	app, _ := newCancelApp()
+	run := github.Run{ID: 7, Status: "in_progress"}
+	now := time.Now()
+
+	if got := app.cancelBadge(run, now); got != "" {
+		t.Errorf("cancelBadge() = %q before any cancel", got)
+	}
+
+	app.Update(RunCancelledMsg{RunID: 7})
+	requested := app.cancelRequested[7]
+	if got := app.cancelBadge(run, requested.Add(40*time.Second)); got != "cancelling 40s" {
+		t.Errorf("cancelBadge() = %q, want cancelling 40s", got)
+	}
+	if got := app.cancelBadge(run, requested.Add(3*time.Minute)); got != "stuck cancelling 3m [C]" {
+		t.Errorf("cancelBadge() = %q, want stuck cancelling 3m [C]", got)
+	}
+
+	run.Status = "completed"
+	if got := app.cancelBadge(run, requested.Add(3*time.Minute)); got != "" {
+		t.Errorf("cancelBadge() = %q for a completed run", got)
+	}
+}
+
+func TestApp_PruneCancelRequests(t *testing.T) {
+	app, _ := newCancelApp()
+	app.cancelRequested[7] = time.Now()
+	app.cancelRequested[8] = time.Now()
+
+	app.pruneCancelRequests([]github.Run{{ID: 7, Status: "in_progress"}, {ID: 8, Status: "completed"}})
+
+	if _, ok := app.cancelRequested[7]; !ok {
+		t.Error("cancel of a running run should be kept")
+	}
+	if _, ok := app.cancelRequested[8]; ok {
+		t.Error("cancel of a completed run should be forgotten")
+	}
+}
+
+func TestApp_ForceCancel(t *testing.T) {
+	app, mock := newCancelApp()
+	pressC := func() tea.Cmd {
+		return app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	}
+
+	// Not cancelled yet
+	msgs := runCmds(pressC())
+	if len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "Cancel the run first") {
+		t.Errorf("msgs = %v, want a hint to cancel first", msgs)
+	}
+
+	// Cancelled, but not stuck yet
+	app.cancelRequested[7] = time.Now()
+	msgs = runCmds(pressC())
+	if len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "force-cancel available in") {
+		t.Errorf("msgs = %v, want the time left", msgs)
+	}
+	if app.showConfirm {
+		t.Fatal("force-cancel must not be offered before the threshold")
+	}
+
+	// Stuck
+	app.cancelRequested[7] = time.Now().Add(-2 * time.Minute)
+	if view := app.View(); !strings.Contains(view, "stuck cancelling") {
+		t.Errorf("runs pane should show the run is stuck:\n%s", view)
+	}
+	pressC()
+	if !app.showConfirm || !strings.Contains(app.confirmMsg, "Force-cancel #3") {
+		t.Fatalf("confirm = %v %q", app.showConfirm, app.confirmMsg)
+	}
+	msg, ok := app.confirmFn()().(RunForceCancelledMsg)
+	if !ok || msg.RunID != 7 {
+		t.Fatalf("confirmFn() = %v, want RunForceCancelledMsg for run 7", msg)
+	}
+	if calls := mock.ForceCancelRunCalls(); len(calls) != 1 || calls[0].RunID != 7 {
+		t.Errorf("ForceCancelRun calls = %v", calls)
+	}
+
+	app.Update(msg)
+	if app.flashMsg != "Run force-cancelled" {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
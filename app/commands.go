@@ -2,23 +2,27 @@ package app
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/notify"
 )
 
 // fetchWorkflows creates a command to fetch workflows.
 // It captures the client and repo to avoid race conditions.
-// Retries on transient errors (rate limits, server errors).
-func fetchWorkflows(client github.Client, repo github.Repository) tea.Cmd {
+// The request is abandoned when ctx is cancelled.
+// Retries on transient errors (rate limits, server errors), reporting each wait to notify.
+func fetchWorkflows(ctx context.Context, client github.Client, repo github.Repository, notify github.RetryNotifyFunc) tea.Cmd {
 	return func() tea.Msg {
 		var workflows []github.Workflow
-		err := github.RetryWithBackoff(context.Background(), 3, func() error {
+		err := github.RetryWithBackoffNotify(ctx, 3, func() error {
 			var e error
-			workflows, e = client.ListWorkflows(context.Background(), repo)
+			workflows, e = client.ListWorkflows(ctx, repo)
 			return e
-		})
+		}, notify)
 		return WorkflowsLoadedMsg{
 			Workflows: workflows,
 			Err:       err,
@@ -28,39 +32,119 @@ func fetchWorkflows(client github.Client, repo github.Repository) tea.Cmd {
 
 // fetchRuns creates a command to fetch runs for a workflow.
 // It captures the client, repo, and workflowID to avoid race conditions.
-// Retries on transient errors (rate limits, server errors).
-func fetchRuns(client github.Client, repo github.Repository, workflowID int64) tea.Cmd {
+// The result is tagged with workflowID so stale results can be dropped.
+// Retries on transient errors (rate limits, server errors), reporting each wait to notify.
+func fetchRuns(ctx context.Context, client github.Client, repo github.Repository, workflowID int64, notify github.RetryNotifyFunc) tea.Cmd {
 	return func() tea.Msg {
 		opts := &github.ListRunsOpts{
 			WorkflowID: workflowID,
 		}
 		var runs []github.Run
-		err := github.RetryWithBackoff(context.Background(), 3, func() error {
+		err := github.RetryWithBackoffNotify(ctx, 3, func() error {
 			var e error
-			runs, e = client.ListRuns(context.Background(), repo, opts)
+			runs, e = client.ListRuns(ctx, repo, opts)
 			return e
-		})
+		}, notify)
 		return RunsLoadedMsg{
-			Runs: runs,
-			Err:  err,
+			WorkflowID: workflowID,
+			Runs:       runs,
+			Err:        err,
 		}
 	}
 }
 
-// fetchJobs creates a command to fetch jobs for a run.
-// It captures the client, repo, and runID to avoid race conditions.
-// Retries on transient errors (rate limits, server errors).
-func fetchJobs(client github.Client, repo github.Repository, runID int64) tea.Cmd {
+// fetchAllRuns creates a command to list every run of a workflow, a page at a time,
+// up to MaxListedRuns.
+// It captures the client, repo, and workflowID to avoid race conditions.
+// Retries on transient errors (rate limits, server errors), reporting each wait to notify.
+func fetchAllRuns(ctx context.Context, client github.Client, repo github.Repository, workflowID int64, notify github.RetryNotifyFunc) tea.Cmd {
+	return func() tea.Msg {
+		var runs []github.Run
+		for page := 1; len(runs) < MaxListedRuns; page++ {
+			opts := &github.ListRunsOpts{
+				WorkflowID: workflowID,
+				PerPage:    ListRunsPageSize,
+				Page:       page,
+			}
+			var pageRuns []github.Run
+			err := github.RetryWithBackoffNotify(ctx, 3, func() error {
+				var e error
+				pageRuns, e = client.ListRuns(ctx, repo, opts)
+				return e
+			}, notify)
+			if err != nil {
+				return AllRunsLoadedMsg{WorkflowID: workflowID, Err: err}
+			}
+			runs = append(runs, pageRuns...)
+			if len(pageRuns) < ListRunsPageSize {
+				break
+			}
+		}
+		return AllRunsLoadedMsg{WorkflowID: workflowID, Runs: runs}
+	}
+}
+
+// fetchJobs creates a command to fetch jobs for a run attempt (0 for the latest).
+// It captures the client, repo, runID, and attempt to avoid race conditions.
+// The result is tagged with runID and attempt so stale results can be dropped.
+// Retries on transient errors (rate limits, server errors), reporting each wait to notify.
+func fetchJobs(ctx context.Context, client github.Client, repo github.Repository, runID int64, attempt int, notify github.RetryNotifyFunc) tea.Cmd {
 	return func() tea.Msg {
 		var jobs []github.Job
-		err := github.RetryWithBackoff(context.Background(), 3, func() error {
+		err := github.RetryWithBackoffNotify(ctx, 3, func() error {
 			var e error
-			jobs, e = client.ListJobs(context.Background(), repo, runID)
+			if attempt > 0 {
+				jobs, e = client.ListJobsForAttempt(ctx, repo, runID, attempt)
+			} else {
+				jobs, e = client.ListJobs(ctx, repo, runID)
+			}
 			return e
-		})
+		}, notify)
 		return JobsLoadedMsg{
-			Jobs: jobs,
-			Err:  err,
+			RunID:   runID,
+			Attempt: attempt,
+			Jobs:    jobs,
+			Err:     err,
+		}
+	}
+}
+
+// fetchRunAttempt creates a command to fetch an earlier attempt of a run.
+// It captures the client, repo, runID, and attempt to avoid race conditions.
+func fetchRunAttempt(ctx context.Context, client github.Client, repo github.Repository, runID int64, attempt int) tea.Cmd {
+	return func() tea.Msg {
+		run, err := client.GetRunAttempt(ctx, repo, runID, attempt)
+		return RunAttemptLoadedMsg{
+			RunID:   runID,
+			Attempt: attempt,
+			Run:     run,
+			Err:     err,
+		}
+	}
+}
+
+// fetchWorkflowUsage creates a command to fetch the billable usage of a workflow.
+// It captures the client, repo, and workflowID to avoid race conditions.
+func fetchWorkflowUsage(ctx context.Context, client github.Client, repo github.Repository, workflowID int64) tea.Cmd {
+	return func() tea.Msg {
+		usage, err := client.GetWorkflowUsage(ctx, repo, workflowID)
+		return UsageLoadedMsg{
+			WorkflowID: workflowID,
+			Usage:      usage,
+			Err:        err,
+		}
+	}
+}
+
+// fetchRunUsage creates a command to fetch the billable usage of a run.
+// It captures the client, repo, and runID to avoid race conditions.
+func fetchRunUsage(ctx context.Context, client github.Client, repo github.Repository, runID int64) tea.Cmd {
+	return func() tea.Msg {
+		usage, err := client.GetRunUsage(ctx, repo, runID)
+		return UsageLoadedMsg{
+			RunID: runID,
+			Usage: usage,
+			Err:   err,
 		}
 	}
 }
@@ -68,15 +152,15 @@ func fetchJobs(client github.Client, repo github.Repository, runID int64) tea.Cm
 // fetchLogs creates a command to fetch logs for a job.
 // It captures the client, repo, and jobID to avoid race conditions.
 // Logs are sanitized to remove potential secrets before display.
-// Retries on transient errors (rate limits, server errors).
-func fetchLogs(client github.Client, repo github.Repository, jobID int64) tea.Cmd {
+// Retries on transient errors (rate limits, server errors), reporting each wait to notify.
+func fetchLogs(ctx context.Context, client github.Client, repo github.Repository, jobID int64, notify github.RetryNotifyFunc) tea.Cmd {
 	return func() tea.Msg {
 		var logs string
-		err := github.RetryWithBackoff(context.Background(), 3, func() error {
+		err := github.RetryWithBackoffNotify(ctx, 3, func() error {
 			var e error
-			logs, e = client.GetJobLogs(context.Background(), repo, jobID)
+			logs, e = client.GetJobLogs(ctx, repo, jobID)
 			return e
-		})
+		}, notify)
 		if err == nil {
 			logs = github.SanitizeLogs(logs)
 		}
@@ -100,25 +184,52 @@ func cancelRun(client github.Client, repo github.Repository, runID int64) tea.Cm
 	}
 }
 
-// rerunWorkflow creates a command to rerun a workflow.
+// forceCancelRun creates a command to force-cancel a workflow run.
 // It captures the client, repo, and runID to avoid race conditions.
-func rerunWorkflow(client github.Client, repo github.Repository, runID int64) tea.Cmd {
+func forceCancelRun(client github.Client, repo github.Repository, runID int64) tea.Cmd {
 	return func() tea.Msg {
-		err := client.RerunWorkflow(context.Background(), repo, runID)
+		err := client.ForceCancelRun(context.Background(), repo, runID)
+		return RunForceCancelledMsg{
+			RunID: runID,
+			Err:   err,
+		}
+	}
+}
+
+// rerunWorkflow creates a command to rerun a workflow.
+// It captures the client, repo, runID, and opts to avoid race conditions.
+func rerunWorkflow(client github.Client, repo github.Repository, runID int64, opts *github.RerunOpts) tea.Cmd {
+	return func() tea.Msg {
+		err := client.RerunWorkflow(context.Background(), repo, runID, opts)
 		return RunRerunMsg{
 			RunID: runID,
+			Debug: opts != nil && opts.EnableDebugLogging,
 			Err:   err,
 		}
 	}
 }
 
 // rerunFailedJobs creates a command to rerun only failed jobs.
-// It captures the client, repo, and runID to avoid race conditions.
-func rerunFailedJobs(client github.Client, repo github.Repository, runID int64) tea.Cmd {
+// It captures the client, repo, runID, and opts to avoid race conditions.
+func rerunFailedJobs(client github.Client, repo github.Repository, runID int64, opts *github.RerunOpts) tea.Cmd {
 	return func() tea.Msg {
-		err := client.RerunFailedJobs(context.Background(), repo, runID)
+		err := client.RerunFailedJobs(context.Background(), repo, runID, opts)
 		return RerunFailedJobsMsg{
 			RunID: runID,
+			Debug: opts != nil && opts.EnableDebugLogging,
+			Err:   err,
+		}
+	}
+}
+
+// rerunJob creates a command to rerun a single job.
+// It captures the client, repo, jobID, and opts to avoid race conditions.
+func rerunJob(client github.Client, repo github.Repository, jobID int64, opts *github.RerunOpts) tea.Cmd {
+	return func() tea.Msg {
+		err := client.RerunJob(context.Background(), repo, jobID, opts)
+		return JobRerunMsg{
+			JobID: jobID,
+			Debug: opts != nil && opts.EnableDebugLogging,
 			Err:   err,
 		}
 	}
@@ -142,6 +253,153 @@ func triggerWorkflow(client github.Client, repo github.Repository, workflowFile
 	}
 }
 
+// bulkRunAction creates a command to apply action to several runs concurrently,
+// with at most BulkActionConcurrency requests in flight.
+// It captures the runs to avoid race conditions.
+func bulkRunAction(name string, runs []github.Run, action func(ctx context.Context, runID int64) error) tea.Cmd {
+	runs = slices.Clone(runs)
+	return func() tea.Msg {
+		var (
+			mu       sync.Mutex
+			wg       sync.WaitGroup
+			failures []RunFailure
+		)
+		sem := make(chan struct{}, BulkActionConcurrency)
+		for _, run := range runs {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(run github.Run) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				if err := action(context.Background(), run.ID); err != nil {
+					mu.Lock()
+					failures = append(failures, RunFailure{Run: run, Err: err})
+					mu.Unlock()
+				}
+			}(run)
+		}
+		wg.Wait()
+
+		slices.SortFunc(failures, func(a, b RunFailure) int {
+			return a.Run.RunNumber - b.Run.RunNumber
+		})
+		return BulkActionMsg{Action: name, Runs: runs, Failures: failures}
+	}
+}
+
+// deleteRun creates a command to delete a workflow run.
+// It captures the client, repo, and runID to avoid race conditions.
+func deleteRun(client github.Client, repo github.Repository, runID int64) tea.Cmd {
+	return func() tea.Msg {
+		err := client.DeleteRun(context.Background(), repo, runID)
+		return RunDeletedMsg{RunID: runID, Err: err}
+	}
+}
+
+// deleteRunLogs creates a command to delete the logs of a workflow run.
+// It captures the client, repo, and runID to avoid race conditions.
+func deleteRunLogs(client github.Client, repo github.Repository, runID int64) tea.Cmd {
+	return func() tea.Msg {
+		err := client.DeleteRunLogs(context.Background(), repo, runID)
+		return RunDeletedMsg{RunID: runID, Err: err}
+	}
+}
+
+// setWorkflowEnabled creates a command to enable or disable a workflow.
+// It captures the client, repo, and workflow to avoid race conditions.
+func setWorkflowEnabled(client github.Client, repo github.Repository, wf github.Workflow, enable bool) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if enable {
+			err = client.EnableWorkflow(context.Background(), repo, wf.ID)
+		} else {
+			err = client.DisableWorkflow(context.Background(), repo, wf.ID)
+		}
+		return WorkflowStateChangedMsg{
+			Workflow: wf.Name,
+			Enabled:  enable,
+			Err:      err,
+		}
+	}
+}
+
+// fetchPendingDeployments creates a command to fetch the deployments of a run waiting for approval.
+// It captures the client, repo, and runID to avoid race conditions.
+func fetchPendingDeployments(ctx context.Context, client github.Client, repo github.Repository, runID int64) tea.Cmd {
+	return func() tea.Msg {
+		deployments, err := client.ListPendingDeployments(ctx, repo, runID)
+		return PendingDeploymentsLoadedMsg{
+			RunID:       runID,
+			Deployments: deployments,
+			Err:         err,
+		}
+	}
+}
+
+// reviewDeployments creates a command to approve or reject pending deployments.
+// It captures the client, repo, and review parameters to avoid race conditions.
+func reviewDeployments(client github.Client, repo github.Repository, runID int64, deployments []github.PendingDeployment, approve bool, comment string) tea.Cmd {
+	ids := make([]int64, len(deployments))
+	names := make([]string, len(deployments))
+	for i, d := range deployments {
+		ids[i] = d.EnvironmentID
+		names[i] = d.Environment
+	}
+	return func() tea.Msg {
+		err := client.ReviewPendingDeployments(context.Background(), repo, runID, ids, approve, comment)
+		return DeploymentsReviewedMsg{
+			RunID:        runID,
+			Environments: names,
+			Approved:     approve,
+			Err:          err,
+		}
+	}
+}
+
+// fetchWatchedRuns creates a command to fetch the watched runs:
+// all runs on branch (if set) plus the individually watched runIDs.
+func fetchWatchedRuns(ctx context.Context, client github.Client, repo github.Repository, branch string, runIDs []int64) tea.Cmd {
+	return func() tea.Msg {
+		var runs []github.Run
+		if branch != "" {
+			branchRuns, err := client.ListRuns(ctx, repo, &github.ListRunsOpts{Branch: branch})
+			if err != nil {
+				return WatchedRunsLoadedMsg{Err: err}
+			}
+			runs = branchRuns
+		}
+
+		for _, id := range runIDs {
+			if slices.ContainsFunc(runs, func(r github.Run) bool { return r.ID == id }) {
+				continue
+			}
+			run, err := client.GetRun(ctx, repo, id)
+			if err != nil {
+				return WatchedRunsLoadedMsg{Runs: runs, Err: err}
+			}
+			runs = append(runs, *run)
+		}
+		return WatchedRunsLoadedMsg{Runs: runs}
+	}
+}
+
+// sendNotification creates a command that delivers a notification.
+// Delivery errors are ignored so a broken notifier cannot disturb the UI.
+func sendNotification(notifier notify.Notifier, n notify.Notification) tea.Cmd {
+	return func() tea.Msg {
+		_ = notifier.Notify(n)
+		return nil
+	}
+}
+
+// waitForRetry creates a command that waits for the next retry notification.
+// It is re-issued after each RetryScheduledMsg to keep listening.
+func waitForRetry(ch <-chan RetryScheduledMsg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
+
 // flashMessage creates a flash message that clears after duration.
 // It returns a batch of commands: the flash message and a delayed clear.
 func flashMessage(msg string, duration time.Duration) tea.Cmd {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -19,7 +20,7 @@ func TestFetchWorkflows(t *testing.T) {
 		})
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 
-		cmd := fetchWorkflows(mock, repo)
+		cmd := fetchWorkflows(context.Background(), mock, repo, nil)
 		msg := cmd()
 
 		result, ok := msg.(WorkflowsLoadedMsg)
@@ -43,7 +44,7 @@ func TestFetchWorkflows(t *testing.T) {
 		})
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 
-		cmd := fetchWorkflows(mock, repo)
+		cmd := fetchWorkflows(context.Background(), mock, repo, nil)
 		msg := cmd()
 
 		result, ok := msg.(WorkflowsLoadedMsg)
@@ -70,7 +71,7 @@ func TestFetchRuns(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		workflowID := int64(1)
 
-		cmd := fetchRuns(mock, repo, workflowID)
+		cmd := fetchRuns(context.Background(), mock, repo, workflowID, nil)
 		msg := cmd()
 
 		result, ok := msg.(RunsLoadedMsg)
@@ -104,7 +105,7 @@ func TestFetchRuns(t *testing.T) {
 		})
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 
-		cmd := fetchRuns(mock, repo, 1)
+		cmd := fetchRuns(context.Background(), mock, repo, 1, nil)
 		msg := cmd()
 
 		result, ok := msg.(RunsLoadedMsg)
@@ -128,7 +129,7 @@ func TestFetchJobs(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		runID := int64(100)
 
-		cmd := fetchJobs(mock, repo, runID)
+		cmd := fetchJobs(context.Background(), mock, repo, runID, 0, nil)
 		msg := cmd()
 
 		result, ok := msg.(JobsLoadedMsg)
@@ -162,7 +163,7 @@ func TestFetchJobs(t *testing.T) {
 		})
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 
-		cmd := fetchJobs(mock, repo, 100)
+		cmd := fetchJobs(context.Background(), mock, repo, 100, 0, nil)
 		msg := cmd()
 
 		result, ok := msg.(JobsLoadedMsg)
@@ -184,7 +185,7 @@ func TestFetchLogs(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		jobID := int64(200)
 
-		cmd := fetchLogs(mock, repo, jobID)
+		cmd := fetchLogs(context.Background(), mock, repo, jobID, nil)
 		msg := cmd()
 
 		result, ok := msg.(LogsLoadedMsg)
@@ -208,7 +209,7 @@ func TestFetchLogs(t *testing.T) {
 		})
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 
-		cmd := fetchLogs(mock, repo, 200)
+		cmd := fetchLogs(context.Background(), mock, repo, 200, nil)
 		msg := cmd()
 
 		result, ok := msg.(LogsLoadedMsg)
@@ -274,7 +275,7 @@ func TestRerunWorkflow(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		runID := int64(67890)
 
-		cmd := rerunWorkflow(mock, repo, runID)
+		cmd := rerunWorkflow(mock, repo, runID, nil)
 		msg := cmd()
 
 		result, ok := msg.(RunRerunMsg)
@@ -299,7 +300,7 @@ func TestRerunWorkflow(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		runID := int64(67890)
 
-		cmd := rerunWorkflow(mock, repo, runID)
+		cmd := rerunWorkflow(mock, repo, runID, nil)
 		msg := cmd()
 
 		result, ok := msg.(RunRerunMsg)
@@ -321,7 +322,7 @@ func TestRerunFailedJobs(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		runID := int64(11111)
 
-		cmd := rerunFailedJobs(mock, repo, runID)
+		cmd := rerunFailedJobs(mock, repo, runID, nil)
 		msg := cmd()
 
 		result, ok := msg.(RerunFailedJobsMsg)
@@ -346,7 +347,7 @@ func TestRerunFailedJobs(t *testing.T) {
 		repo := github.Repository{Owner: "owner", Name: "repo"}
 		runID := int64(11111)
 
-		cmd := rerunFailedJobs(mock, repo, runID)
+		cmd := rerunFailedJobs(mock, repo, runID, nil)
 		msg := cmd()
 
 		result, ok := msg.(RerunFailedJobsMsg)
@@ -479,22 +480,22 @@ func TestCommandsAreFunctions(t *testing.T) {
 	// All these should compile and return tea.Cmd
 	var cmd tea.Cmd
 
-	cmd = fetchWorkflows(mock, repo)
+	cmd = fetchWorkflows(context.Background(), mock, repo, nil)
 	if cmd == nil {
 		t.Error("fetchWorkflows returned nil")
 	}
 
-	cmd = fetchRuns(mock, repo, 1)
+	cmd = fetchRuns(context.Background(), mock, repo, 1, nil)
 	if cmd == nil {
 		t.Error("fetchRuns returned nil")
 	}
 
-	cmd = fetchJobs(mock, repo, 1)
+	cmd = fetchJobs(context.Background(), mock, repo, 1, 0, nil)
 	if cmd == nil {
 		t.Error("fetchJobs returned nil")
 	}
 
-	cmd = fetchLogs(mock, repo, 1)
+	cmd = fetchLogs(context.Background(), mock, repo, 1, nil)
 	if cmd == nil {
 		t.Error("fetchLogs returned nil")
 	}
@@ -504,12 +505,12 @@ func TestCommandsAreFunctions(t *testing.T) {
 		t.Error("cancelRun returned nil")
 	}
 
-	cmd = rerunWorkflow(mock, repo, 1)
+	cmd = rerunWorkflow(mock, repo, 1, nil)
 	if cmd == nil {
 		t.Error("rerunWorkflow returned nil")
 	}
 
-	cmd = rerunFailedJobs(mock, repo, 1)
+	cmd = rerunFailedJobs(mock, repo, 1, nil)
 	if cmd == nil {
 		t.Error("rerunFailedJobs returned nil")
 	}
@@ -542,10 +543,10 @@ func TestConcurrentCommandExecution(t *testing.T) {
 
 	// Create multiple commands
 	cmds := []tea.Cmd{
-		fetchWorkflows(mock, repo),
-		fetchRuns(mock, repo, 1),
-		fetchJobs(mock, repo, 100),
-		fetchLogs(mock, repo, 200),
+		fetchWorkflows(context.Background(), mock, repo, nil),
+		fetchRuns(context.Background(), mock, repo, 1, nil),
+		fetchJobs(context.Background(), mock, repo, 100, 0, nil),
+		fetchLogs(context.Background(), mock, repo, 200, nil),
 	}
 
 	// Execute them concurrently
@@ -0,0 +1,258 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Workflow definition - the YAML of the selected workflow at the selected run's
+// commit, shown in the Workflow tab with the changes made in the working copy
+
+// Sources of workflow definitions
+const (
+	sourceLocalGit = "local git"
+	sourceGitHub   = "GitHub"
+)
+
+// LocalRepo reads files of the local clone of the repository
+type LocalRepo interface {
+	// ShowFile returns the content of path at commit sha (git show sha:path)
+	ShowFile(sha, path string) (string, error)
+	// ReadFile returns the content of path in the working copy
+	ReadFile(path string) (string, error)
+	// Root returns the top-level directory of the working copy
+	Root() (string, error)
+}
+
+// definitionKey identifies a workflow file at a ref ("" for the default branch)
+type definitionKey struct {
+	path string
+	ref  string
+}
+
+// definitionState is a workflow definition, once loaded
+type definitionState struct {
+	content    string
+	source     string
+	working    string
+	hasWorking bool // Whether the file exists in the working copy
+	err        error
+	loading    bool
+}
+
+// YAML syntax highlighting
+var (
+	yamlKeyRegex  = regexp.MustCompile(`^(\s*(?:- )?)([\w.-]+|"[^"]*"|'[^']*'):(\s|$)`)
+	yamlExprRegex = regexp.MustCompile(`\$\{\{.*?\}\}`)
+)
+
+// definitionRef returns the commit the workflow definition is shown at:
+// the selected run's head SHA, or "" for the default branch
+func (a *App) definitionRef() string {
+	if run, ok := a.runs.Selected(); ok {
+		return run.HeadSHA
+	}
+	return ""
+}
+
+// definitionKeyFor returns the workflow file shown in the Workflow tab
+func (a *App) definitionKeyFor() (definitionKey, bool) {
+	wf, ok := a.workflows.Selected()
+	if !ok || wf.Path == "" {
+		return definitionKey{}, false
+	}
+	return definitionKey{path: wf.Path, ref: a.definitionRef()}, true
+}
+
+// fetchDefinitionCmd fetches the workflow definition shown in the Workflow and
+// Graph tabs unless it is already known, cancelling the request of the previously
+// shown one. Nothing is fetched while another tab is shown.
+func (a *App) fetchDefinitionCmd() tea.Cmd {
+	if a.client == nil || (a.detailTab != WorkflowTab && a.detailTab != GraphTab) {
+		return nil
+	}
+	key, ok := a.definitionKeyFor()
+	if !ok {
+		return nil
+	}
+	if key != a.definitionShown {
+		a.definitionShown = key
+		a.definitionOffset = 0
+	}
+	if _, known := a.definitions[key]; known {
+		return nil
+	}
+	a.definitions[key] = definitionState{loading: true}
+	ctx := newRequestContext(&a.cancelDefinition)
+	return fetchWorkflowDefinition(ctx, a.client, a.local, a.repo, key)
+}
+
+// fetchWorkflowDefinition creates a command to read a workflow file at a ref,
+// from local git when the commit is available and from GitHub otherwise,
+// along with its working copy.
+// It captures the client, local repository, repo, and key to avoid race conditions.
+func fetchWorkflowDefinition(ctx context.Context, client github.Client, local LocalRepo, repo github.Repository, key definitionKey) tea.Cmd {
+	return func() tea.Msg {
+		msg := WorkflowDefinitionLoadedMsg{Path: key.path, Ref: key.ref}
+		if local != nil && key.ref != "" {
+			if content, err := local.ShowFile(key.ref, key.path); err == nil {
+				msg.Content, msg.Source = content, sourceLocalGit
+			}
+		}
+		if msg.Source == "" {
+			msg.Content, msg.Err = client.GetFileContent(ctx, repo, key.path, key.ref)
+			msg.Source = sourceGitHub
+		}
+		if local != nil {
+			if working, err := local.ReadFile(key.path); err == nil {
+				msg.Working, msg.HasWorking = working, true
+			}
+		}
+		return msg
+	}
+}
+
+// handleWorkflowDefinitionLoaded caches a loaded workflow definition.
+// A cancelled request is forgotten, and made again if the definition is
+// shown again meanwhile.
+func (a *App) handleWorkflowDefinitionLoaded(msg WorkflowDefinitionLoadedMsg) tea.Cmd {
+	key := definitionKey{path: msg.Path, ref: msg.Ref}
+	if isCancelled(msg.Err) {
+		delete(a.definitions, key)
+		return a.fetchDefinitionCmd()
+	}
+	if msg.Err != nil {
+		a.definitions[key] = definitionState{err: msg.Err}
+		return nil
+	}
+	a.definitions[key] = definitionState{
+		content:    msg.Content,
+		source:     msg.Source,
+		working:    msg.Working,
+		hasWorking: msg.HasWorking,
+	}
+	return nil
+}
+
+// splitFileLines splits file content into lines, without the final newline
+// and carriage returns
+func splitFileLines(content string) []string {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// definitionBody returns the scrollable lines of the Workflow tab:
+// the changes in the working copy, if any, then the numbered YAML
+func definitionBody(state definitionState, maxWidth int) []string {
+	lines := splitFileLines(state.content)
+	var body []string
+
+	if state.hasWorking {
+		same := func(s string) string { return s }
+		diff, changed := diffLines(lines, splitFileLines(state.working), same, same)
+		if changed {
+			added, removed := 0, 0
+			for _, line := range diff {
+				switch line.kind {
+				case diffAdded:
+					added++
+				case diffRemoved:
+					removed++
+				}
+			}
+			body = append(body, fmt.Sprintf("  Working copy differs (%s %s):",
+				DiffAddedStyle.Render(fmt.Sprintf("+%d", added)), DiffRemovedStyle.Render(fmt.Sprintf("-%d", removed))))
+			for _, line := range diff {
+				body = append(body, "  "+renderDiffLine(line, maxWidth-2))
+			}
+			body = append(body, "  "+strings.Repeat("─", 30))
+		}
+	}
+
+	width := len(fmt.Sprint(len(lines)))
+	for i, line := range lines {
+		number := QueuedStyle.Render(fmt.Sprintf("%*d ", width, i+1))
+		body = append(body, "  "+number+highlightYAML(truncateString(line, maxWidth-width-3)))
+	}
+	return body
+}
+
+// definitionTitle returns the line naming the workflow file and the commit it is shown at
+func (a *App) definitionTitle(key definitionKey, maxWidth int) string {
+	at := "default branch"
+	if key.ref != "" {
+		at = shortSHA(key.ref)
+		if run, ok := a.runs.Selected(); ok {
+			at += fmt.Sprintf(" (run #%d)", run.RunNumber)
+		}
+	}
+	return "  " + truncateString(key.path+" @ "+at, maxWidth-2)
+}
+
+// buildDefinitionContent builds the content of the Workflow tab
+func (a *App) buildDefinitionContent(maxWidth int) []string {
+	key, ok := a.definitionKeyFor()
+	if !ok {
+		return []string{"  Select a workflow"}
+	}
+	content := []string{a.definitionTitle(key, maxWidth)}
+
+	state, known := a.definitions[key]
+	switch {
+	case !known || state.loading:
+		return append(content, "", "  Loading...")
+	case state.err != nil:
+		return append(content, "", "  Unavailable: "+state.err.Error())
+	}
+	content = append(content, "  "+QueuedStyle.Render("from "+state.source+"  (PgUp/PgDn scroll)"), "")
+
+	body := definitionBody(state, maxWidth)
+	offset := min(a.definitionOffset, max(len(body)-1, 0))
+	return append(content, body[offset:]...)
+}
+
+// scrollDefinition scrolls the Workflow tab by n lines (up if negative)
+func (a *App) scrollDefinition(n int) {
+	key, ok := a.definitionKeyFor()
+	if !ok {
+		return
+	}
+	lines := len(definitionBody(a.definitions[key], DefaultWrapWidth))
+	a.definitionOffset = max(min(a.definitionOffset+n, lines-1), 0)
+}
+
+// highlightYAML colors the keys, ${{ }} expressions, and comments of a YAML line
+func highlightYAML(line string) string {
+	if strings.HasPrefix(strings.TrimSpace(line), "#") {
+		return YAMLCommentStyle.Render(line)
+	}
+	code, comment := line, ""
+	if i := strings.Index(line, " #"); i >= 0 {
+		code, comment = line[:i], line[i:]
+	}
+
+	if m := yamlKeyRegex.FindStringSubmatchIndex(code); m != nil {
+		code = code[:m[4]] + YAMLKeyStyle.Render(code[m[4]:m[5]]) + highlightExpressions(code[m[5]:])
+	} else {
+		code = highlightExpressions(code)
+	}
+	if comment != "" {
+		code += YAMLCommentStyle.Render(comment)
+	}
+	return code
+}
+
+// highlightExpressions colors the ${{ }} expressions of text
+func highlightExpressions(text string) string {
+	return yamlExprRegex.ReplaceAllStringFunc(text, func(expr string) string {
+		return YAMLExprStyle.Render(expr)
+	})
+}
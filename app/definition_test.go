@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// mockLocalRepo serves files by "sha:path", and the working copy by path
+type mockLocalRepo struct {
+	commits map[string]string
+	working map[string]string
+	root    string
+}
+
+func (m *mockLocalRepo) Root() (string, error) {
+	if m.root == "" {
+		return "", errors.New("not a git repository")
+	}
+	return m.root, nil
+}
+
+func (m *mockLocalRepo) ShowFile(sha, path string) (string, error) {
+	if content, ok := m.commits[sha+":"+path]; ok {
+		return content, nil
+	}
+	return "", errors.New("unknown commit")
+}
+
+func (m *mockLocalRepo) ReadFile(path string) (string, error) {
+	if content, ok := m.working[path]; ok {
+		return content, nil
+	}
+	return "", errors.New("no such file")
+}
+
+const testWorkflowPath = ".github/workflows/ci.yml"
+
+func newDefinitionTestApp(state *mockClientState, local LocalRepo) (*App, *github.MockClient) {
+	mock := newMockClient(state)
+	app := New(WithClient(mock), WithLocalRepo(local))
+	app.width = 120
+	app.height = 40
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI", Path: testWorkflowPath}})
+	app.runs.SetItems([]github.Run{{ID: 10, RunNumber: 7, HeadSHA: "abc1234def"}})
+	return app, mock
+}
+
+func TestApp_WorkflowTab_FromLocalGit(t *testing.T) {
+	local := &mockLocalRepo{
+		commits: map[string]string{"abc1234def:" + testWorkflowPath: "name: CI\non: push\n"},
+		working: map[string]string{testWorkflowPath: "name: CI\non: pull_request\n"},
+	}
+	app, mock := newDefinitionTestApp(nil, local)
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}})
+	if app.detailTab != WorkflowTab {
+		t.Fatal("3 should show the Workflow tab")
+	}
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+	if calls := mock.GetFileContentCalls(); len(calls) != 0 {
+		t.Errorf("GetFileContent called %d times, want the commit read from local git", len(calls))
+	}
+
+	view := app.View()
+	for _, want := range []string{"[3] Workflow", testWorkflowPath + " @ abc1234 (run #7)", "from local git",
+		"Working copy differs", "- on: push", "+ on: pull_request", "2 on: push"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Workflow tab should contain %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestApp_WorkflowTab_FromGitHub(t *testing.T) {
+	state := &mockClientState{files: map[string]string{testWorkflowPath + "@abc1234def": "name: CI\n"}}
+	app, mock := newDefinitionTestApp(state, &mockLocalRepo{})
+	app.detailTab = WorkflowTab
+
+	for _, msg := range runCmds(app.fetchDefinitionCmd()) {
+		app.Update(msg)
+	}
+	if calls := mock.GetFileContentCalls(); len(calls) != 1 || calls[0].Ref != "abc1234def" {
+		t.Fatalf("GetFileContent calls = %v, want the run's commit", calls)
+	}
+	// Cached once loaded
+	if cmd := app.fetchDefinitionCmd(); cmd != nil {
+		t.Error("a loaded definition should not be fetched again")
+	}
+
+	view := app.View()
+	if !strings.Contains(view, "from GitHub") || strings.Contains(view, "Working copy differs") {
+		t.Errorf("Workflow tab should show the file from GitHub without a diff:\n%s", view)
+	}
+}
+
+func TestApp_WorkflowTab_Cancelled(t *testing.T) {
+	app, _ := newDefinitionTestApp(&mockClientState{}, &mockLocalRepo{})
+	app.detailTab = WorkflowTab
+
+	if cmd := app.fetchDefinitionCmd(); cmd == nil || app.cancelDefinition == nil {
+		t.Fatal("the definition request should be cancellable")
+	}
+	key, _ := app.definitionKeyFor()
+	_, cmd := app.Update(WorkflowDefinitionLoadedMsg{Path: key.path, Ref: key.ref, Err: context.Canceled})
+	if state := app.definitions[key]; !state.loading || cmd == nil {
+		t.Error("a cancelled definition still shown should be fetched again")
+	}
+}
+
+func TestApp_WorkflowTab_NotFetchedInOtherTabs(t *testing.T) {
+	app, _ := newDefinitionTestApp(nil, nil)
+	app.detailTab = InfoTab
+
+	if cmd := app.fetchDefinitionCmd(); cmd != nil {
+		t.Error("the definition should only be fetched for the Workflow tab")
+	}
+}
+
+func TestApp_ScrollDefinition(t *testing.T) {
+	app, _ := newDefinitionTestApp(nil, nil)
+	app.detailTab = WorkflowTab
+	key, _ := app.definitionKeyFor()
+	app.definitions[key] = definitionState{content: "a: 1\nb: 2\nc: 3\n", source: sourceGitHub}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyPgDown})
+	if app.definitionOffset != 2 {
+		t.Errorf("definitionOffset = %d, want the last line", app.definitionOffset)
+	}
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyPgUp})
+	if app.definitionOffset != 0 {
+		t.Errorf("definitionOffset = %d, want the first line", app.definitionOffset)
+	}
+}
+
+func TestHighlightYAML(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"# comment", YAMLCommentStyle.Render("# comment")},
+		{"  runs-on: ubuntu-latest", "  " + YAMLKeyStyle.Render("runs-on") + ": ubuntu-latest"},
+		{"  - name: Test # why", "  - " + YAMLKeyStyle.Render("name") + ": Test" + YAMLCommentStyle.Render(" # why")},
+		{"    if: ${{ github.ref == 'main' }}", "    " + YAMLKeyStyle.Render("if") + ": " + YAMLExprStyle.Render("${{ github.ref == 'main' }}")},
+		{"      go test ./...", "      go test ./..."},
+	}
+	for _, tt := range tests {
+		if got := highlightYAML(tt.line); got != tt.want {
+			t.Errorf("highlightYAML(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Run deletion - deleting runs and purging logs cannot be undone,
+// so it is confirmed by typing a phrase instead of y/n
+
+// MaxFailuresShown caps the per-run failures listed in bulk action summaries
+const MaxFailuresShown = 10
+
+// deleteKind is what a delete dialog deletes
+type deleteKind int
+
+const (
+	deleteRunsKind deleteKind = iota
+	purgeLogsKind
+)
+
+// deleteDialog is the state of the delete dialog: typed confirmation,
+// then progress, then (for several runs) a summary
+type deleteDialog struct {
+	kind     deleteKind
+	runs     []github.Run
+	phrase   string // Must be typed to confirm
+	input    textinput.Model
+	running  bool
+	stopping bool                 // Esc pressed while running: start no more deletions
+	started  int                  // Runs whose deletion has started, in order
+	pending  map[int64]github.Run // Deletions in flight
+	done     int
+	failures []RunFailure
+	finished bool
+}
+
+// newDeleteDialog creates a delete dialog for runs.
+// A single run is confirmed by its number, several runs by "delete N" or "purge N".
+func newDeleteDialog(kind deleteKind, runs []github.Run) *deleteDialog {
+	phrase := strconv.Itoa(runs[0].RunNumber)
+	if len(runs) > 1 {
+		verb := "delete"
+		if kind == purgeLogsKind {
+			verb = "purge"
+		}
+		phrase = verb + " " + strconv.Itoa(len(runs))
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = phrase
+	ti.CharLimit = len(phrase) + 10
+	ti.Focus()
+
+	return &deleteDialog{
+		kind:   kind,
+		runs:   runs,
+		phrase: phrase,
+		input:  ti,
+	}
+}
+
+// bulk returns true if the dialog deletes several runs
+func (d *deleteDialog) bulk() bool {
+	return len(d.runs) > 1
+}
+
+// title describes what the dialog deletes
+func (d *deleteDialog) title() string {
+	switch {
+	case d.kind == purgeLogsKind && d.bulk():
+		return fmt.Sprintf("Purge all logs of %d runs?", len(d.runs))
+	case d.kind == purgeLogsKind:
+		return fmt.Sprintf("Purge all logs of run #%d?", d.runs[0].RunNumber)
+	case d.bulk():
+		return fmt.Sprintf("Delete %d runs and their logs?", len(d.runs))
+	default:
+		return fmt.Sprintf("Delete run #%d and its logs?", d.runs[0].RunNumber)
+	}
+}
+
+// startCmd starts deleting the next runs, with at most BulkActionConcurrency
+// deletions in flight, unless stopping
+func (d *deleteDialog) startCmd(client github.Client, repo github.Repository) tea.Cmd {
+	var cmds []tea.Cmd
+	for !d.stopping && d.started < len(d.runs) && len(d.pending) < BulkActionConcurrency {
+		run := d.runs[d.started]
+		d.started++
+		d.pending[run.ID] = run
+		if d.kind == purgeLogsKind {
+			cmds = append(cmds, deleteRunLogs(client, repo, run.ID))
+		} else {
+			cmds = append(cmds, deleteRun(client, repo, run.ID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// deleteTargets returns the marked runs, or the selected run if none are marked
+func (a *App) deleteTargets() []github.Run {
+	if marked := a.runs.Marked(); len(marked) > 0 {
+		return marked
+	}
+	if run, ok := a.runs.Selected(); ok {
+		return []github.Run{run}
+	}
+	return nil
+}
+
+// confirmDeleteRun opens the delete dialog for the marked runs or the selected run
+func (a *App) confirmDeleteRun() tea.Cmd {
+	runs := a.deleteTargets()
+	if len(runs) == 0 {
+		return nil
+	}
+	a.deleting = newDeleteDialog(deleteRunsKind, runs)
+	return textinput.Blink
+}
+
+// confirmDeleteRunLogs opens the delete dialog to purge the logs of the marked runs or the selected run
+func (a *App) confirmDeleteRunLogs() tea.Cmd {
+	runs := a.deleteTargets()
+	if len(runs) == 0 {
+		return nil
+	}
+	a.deleting = newDeleteDialog(purgeLogsKind, runs)
+	return textinput.Blink
+}
+
+// confirmDeleteFilteredRuns lists every run of the selected workflow, not only
+// the loaded page, to open the delete dialog for those matching the current filter
+func (a *App) confirmDeleteFilteredRuns() tea.Cmd {
+	wf, ok := a.workflows.Selected()
+	if !ok || a.runs.Len() == 0 || a.client == nil {
+		return nil
+	}
+	ctx := newRequestContext(&a.cancelAllRuns)
+	return tea.Batch(
+		flashMessage("Listing all runs...", FlashDurationInfo),
+		fetchAllRuns(ctx, a.client, a.repo, wf.ID, a.retryNotifier()),
+	)
+}
+
+// handleAllRunsLoaded opens the delete dialog for the listed runs matching the current filter
+func (a *App) handleAllRunsLoaded(msg AllRunsLoadedMsg) tea.Cmd {
+	if isCancelled(msg.Err) {
+		return nil
+	}
+	if wf, ok := a.workflows.Selected(); !ok || wf.ID != msg.WorkflowID {
+		return nil
+	}
+	if msg.Err != nil {
+		a.err = msg.Err
+		return nil
+	}
+	runs := a.runs.Matching(msg.Runs)
+	if len(runs) == 0 {
+		return flashMessage("No runs match the filter", FlashDurationInfo)
+	}
+	a.deleting = newDeleteDialog(deleteRunsKind, runs)
+	return textinput.Blink
+}
+
+// handleDeleteInput handles input when in the delete dialog
+func (a *App) handleDeleteInput(msg tea.KeyMsg) tea.Cmd {
+	d := a.deleting
+
+	switch {
+	case d.finished:
+		switch msg.String() {
+		case "enter", "esc", "q":
+			a.deleting = nil
+		}
+		return nil
+
+	case d.running:
+		if msg.String() == "esc" {
+			d.stopping = true
+		}
+		return nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		a.deleting = nil
+		return nil
+	case "enter":
+		if strings.TrimSpace(d.input.Value()) != d.phrase {
+			return flashMessage("Type "+d.phrase+" to confirm", FlashDurationInfo)
+		}
+		d.running = true
+		d.input.Blur()
+		d.pending = make(map[int64]github.Run)
+		return d.startCmd(a.client, a.repo)
+	}
+
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return cmd
+}
+
+// handleRunDeleted records the result of one deletion and starts the next one
+func (a *App) handleRunDeleted(msg RunDeletedMsg) tea.Cmd {
+	d := a.deleting
+	if d == nil || !d.running {
+		return nil
+	}
+	run, ok := d.pending[msg.RunID]
+	if !ok {
+		return nil
+	}
+	delete(d.pending, msg.RunID)
+
+	if msg.Err != nil {
+		d.failures = append(d.failures, RunFailure{Run: run, Err: msg.Err})
+	}
+	d.done++
+	if next := d.startCmd(a.client, a.repo); len(d.pending) > 0 {
+		return next
+	}
+
+	slices.SortFunc(d.failures, func(a, b RunFailure) int {
+		return a.Run.RunNumber - b.Run.RunNumber
+	})
+	d.running = false
+	d.finished = true
+	a.runs.ClearMarks()
+	refresh := a.refreshCurrentWorkflow()
+
+	// Single runs report in the status bar; bulk deletion keeps the summary open
+	if !d.bulk() {
+		a.deleting = nil
+		if msg.Err != nil {
+			a.err = msg.Err
+			return refresh
+		}
+		if d.kind == purgeLogsKind {
+			a.flashMsg = "Logs purged for #" + strconv.Itoa(d.runs[0].RunNumber)
+		} else {
+			a.flashMsg = "Run #" + strconv.Itoa(d.runs[0].RunNumber) + " deleted"
+		}
+	}
+	return refresh
+}
+
+// deleteStatus returns the progress or result of the deletion (e.g., "Deleting runs 3/17")
+func (d *deleteDialog) deleteStatus() string {
+	verb, done := "Deleting runs", "Deleted"
+	if d.kind == purgeLogsKind {
+		verb, done = "Purging logs", "Purged logs of"
+	}
+	succeeded := d.done - len(d.failures)
+	switch {
+	case d.running && d.stopping:
+		return fmt.Sprintf("Stopping after %d in progress...", len(d.pending))
+	case d.running:
+		return fmt.Sprintf("%s %d/%d...", verb, d.done, len(d.runs))
+	case len(d.failures) > 0:
+		return fmt.Sprintf("%s %d of %d runs, %d failed", done, succeeded, len(d.runs), len(d.failures))
+	default:
+		return fmt.Sprintf("%s %d of %d runs", done, succeeded, len(d.runs))
+	}
+}
+
+// renderDeleteDialog renders the delete dialog
+func (a *App) renderDeleteDialog() string {
+	d := a.deleting
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(d.title()), ""}
+	switch {
+	case d.running || d.finished:
+		lines = append(lines, d.deleteStatus())
+		for i, f := range d.failures {
+			if i == MaxFailuresShown {
+				lines = append(lines, fmt.Sprintf("  ... and %d more", len(d.failures)-i))
+				break
+			}
+			lines = append(lines, FailureStyle.Render("✗")+fmt.Sprintf(" #%d: %v", f.Run.RunNumber, f.Err))
+		}
+		if d.finished {
+			lines = append(lines, "", "[Enter] Close")
+		} else {
+			lines = append(lines, "", "[Esc] Stop")
+		}
+	default:
+		lines = append(lines,
+			"This cannot be undone. Type "+lipgloss.NewStyle().Bold(true).Render(d.phrase)+" to confirm:",
+			"",
+			d.input.View(),
+			"",
+			"[Enter] Delete  [Esc] Cancel",
+		)
+	}
+
+	dialog := DeleteDialog.Width(60).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height,
+		lipgloss.Center, lipgloss.Center, dialog)
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newDeleteApp(mock *github.MockClient) *App {
+	app := New(WithClient(mock), WithRepository(github.Repository{Owner: "owner", Name: "repo"}))
+	app.width = 100
+	app.height = 40
+	app.focusedPane = RunsPane
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+	runs := []github.Run{
+		{ID: 10, RunNumber: 42, Branch: "main"},
+		{ID: 11, RunNumber: 43, Branch: "dependabot/npm"},
+		{ID: 12, RunNumber: 44, Branch: "dependabot/go"},
+	}
+	app.runs.SetItems(runs)
+	listRuns(mock, runs)
+	return app
+}
+
+// listRuns makes mock list runs a page at a time
+func listRuns(mock *github.MockClient, runs []github.Run) {
+	mock.ListRunsFunc = func(_ context.Context, _ github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
+		from := min((opts.Page-1)*opts.PerPage, len(runs))
+		return runs[from:min(from+opts.PerPage, len(runs))], nil
+	}
+}
+
+// listAllRuns feeds the runs listed for the bulk delete dialog back into the app
+func listAllRuns(app *App, cmd tea.Cmd) {
+	for _, msg := range runCmds(cmd) {
+		if msg, ok := msg.(AllRunsLoadedMsg); ok {
+			app.Update(msg)
+		}
+	}
+}
+
+func typeText(app *App, s string) {
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+}
+
+func pressKey(app *App, t tea.KeyType) tea.Cmd {
+	return app.handleKeyPress(tea.KeyMsg{Type: t})
+}
+
+// drainDeletes feeds deletion results back into the app, one at a time,
+// until no command is left
+func drainDeletes(app *App, cmd tea.Cmd) {
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		cmd, queue = queue[0], queue[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		case RunDeletedMsg:
+			queue = append(queue, app.handleRunDeleted(msg))
+		}
+	}
+}
+
+func TestApp_DeleteRun_RequiresTypedConfirmation(t *testing.T) {
+	mock := newMockClient(nil)
+	app := newDeleteApp(mock)
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	if app.deleting == nil {
+		t.Fatal("delete dialog should be open")
+	}
+	if view := app.View(); !strings.Contains(view, "#42") {
+		t.Errorf("dialog should name the run:\n%s", view)
+	}
+
+	// "y" is not enough
+	typeText(app, "y")
+	if cmd := pressKey(app, tea.KeyEnter); cmd == nil {
+		t.Error("expected a flash message explaining what to type")
+	}
+	if app.deleting.running || len(mock.DeleteRunCalls()) != 0 {
+		t.Fatal("run must not be deleted without the typed confirmation")
+	}
+
+	pressKey(app, tea.KeyBackspace)
+	typeText(app, "42")
+	drainDeletes(app, pressKey(app, tea.KeyEnter))
+
+	if calls := mock.DeleteRunCalls(); len(calls) != 1 || calls[0].RunID != 10 {
+		t.Errorf("DeleteRun calls = %+v, want run 10", calls)
+	}
+	if app.deleting != nil {
+		t.Error("dialog should close after deleting a single run")
+	}
+	if app.flashMsg != "Run #42 deleted" {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
+
+func TestApp_DeleteRunLogs(t *testing.T) {
+	mock := newMockClient(nil)
+	app := newDeleteApp(mock)
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	typeText(app, "42")
+	drainDeletes(app, pressKey(app, tea.KeyEnter))
+
+	if calls := mock.DeleteRunLogsCalls(); len(calls) != 1 || calls[0].RunID != 10 {
+		t.Errorf("DeleteRunLogs calls = %+v, want run 10", calls)
+	}
+	if len(mock.DeleteRunCalls()) != 0 {
+		t.Error("purging logs must not delete the run")
+	}
+	if app.flashMsg != "Logs purged for #42" {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
+
+func TestApp_DeleteFilteredRuns(t *testing.T) {
+	mock := newMockClient(nil)
+	mock.DeleteRunFunc = func(_ context.Context, _ github.Repository, runID int64) error {
+		if runID == 12 {
+			return &github.AppError{Type: github.ErrTypeAuth, Message: "Permission denied"}
+		}
+		return nil
+	}
+	app := newDeleteApp(mock)
+	app.runs.SetFilter("dependabot")
+
+	listAllRuns(app, app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}}))
+	if app.deleting == nil || len(app.deleting.runs) != 2 {
+		t.Fatalf("bulk dialog should cover the 2 filtered runs, got %+v", app.deleting)
+	}
+	typeText(app, "delete 2")
+
+	cmd := pressKey(app, tea.KeyEnter)
+	if got := app.deleting.deleteStatus(); got != "Deleting runs 0/2..." {
+		t.Errorf("deleteStatus() = %q", got)
+	}
+	drainDeletes(app, cmd)
+
+	if calls := mock.DeleteRunCalls(); len(calls) != 2 {
+		t.Fatalf("DeleteRun called %d times, want 2", len(calls))
+	}
+	if app.deleting == nil || !app.deleting.finished {
+		t.Fatal("bulk deletion should keep the summary open")
+	}
+	view := app.View()
+	if !strings.Contains(view, "Deleted 1 of 2 runs, 1 failed") || !strings.Contains(view, "#44: Permission denied") {
+		t.Errorf("summary should list per-run failures:\n%s", view)
+	}
+
+	pressKey(app, tea.KeyEnter)
+	if app.deleting != nil {
+		t.Error("Enter should close the summary")
+	}
+}
+
+func TestApp_DeleteFilteredRuns_Stop(t *testing.T) {
+	mock := newMockClient(nil)
+	app := newDeleteApp(mock)
+	var runs []github.Run
+	for i := range 6 {
+		runs = append(runs, github.Run{ID: int64(20 + i), RunNumber: 50 + i})
+	}
+	app.runs.SetItems(runs)
+	listRuns(mock, runs)
+
+	listAllRuns(app, app.confirmDeleteFilteredRuns())
+	typeText(app, "delete 6")
+	cmd := pressKey(app, tea.KeyEnter)
+	pressKey(app, tea.KeyEsc)
+	if got := app.deleting.deleteStatus(); got != "Stopping after 4 in progress..." {
+		t.Errorf("deleteStatus() = %q", got)
+	}
+	drainDeletes(app, cmd)
+
+	if calls := mock.DeleteRunCalls(); len(calls) != BulkActionConcurrency {
+		t.Errorf("DeleteRun called %d times, want to stop after the runs in progress", len(calls))
+	}
+	if !app.deleting.finished {
+		t.Error("stopped deletion should show the summary")
+	}
+}
+
+func TestApp_DeleteFilteredRuns_ListsEveryPage(t *testing.T) {
+	mock := newMockClient(nil)
+	app := newDeleteApp(mock)
+	var runs []github.Run
+	for i := range 250 {
+		branch := "main"
+		if i%2 == 0 {
+			branch = "dependabot/go"
+		}
+		runs = append(runs, github.Run{ID: int64(100 + i), RunNumber: 100 + i, Branch: branch})
+	}
+	listRuns(mock, runs)
+	app.runs.SetItems(runs[:30]) // The loaded page
+	app.runs.SetFilter("dependabot")
+
+	listAllRuns(app, app.confirmDeleteFilteredRuns())
+	if len(mock.ListRunsCalls()) != 3 {
+		t.Errorf("ListRuns called %d times, want every page", len(mock.ListRunsCalls()))
+	}
+	if app.deleting == nil || app.deleting.phrase != "delete 125" {
+		t.Fatalf("dialog should cover the 125 matching runs, got %+v", app.deleting)
+	}
+	if view := app.View(); !strings.Contains(view, "Delete 125 runs") {
+		t.Errorf("dialog should show the total:\n%s", view)
+	}
+}
+
+func TestApp_DeleteMarkedRuns(t *testing.T) {
+	mock := newMockClient(nil)
+	app := newDeleteApp(mock)
+	app.runs.ToggleMark()
+	app.runs.SelectNext()
+	app.runs.SelectNext()
+	app.runs.ToggleMark()
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	if app.deleting == nil || app.deleting.phrase != "purge 2" {
+		t.Fatalf("dialog should purge the 2 marked runs, got %+v", app.deleting)
+	}
+	typeText(app, "purge 2")
+	drainDeletes(app, pressKey(app, tea.KeyEnter))
+
+	calls := mock.DeleteRunLogsCalls()
+	if len(calls) != 2 || calls[0].RunID != 10 || calls[1].RunID != 12 {
+		t.Errorf("DeleteRunLogs calls = %+v, want runs 10 and 12", calls)
+	}
+	if got := app.deleting.deleteStatus(); got != "Purged logs of 2 of 2 runs" {
+		t.Errorf("deleteStatus() = %q", got)
+	}
+	if app.runs.MarkedCount() != 0 {
+		t.Error("marks should be cleared after deleting")
+	}
+}
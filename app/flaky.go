@@ -0,0 +1,407 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Flaky jobs - jobs that both failed and succeeded for the same commit,
+// across the attempts of a run and across runs of the same head SHA
+
+// FlakyMaxRuns caps the runs whose jobs are fetched to detect flaky jobs
+const FlakyMaxRuns = 20
+
+// FlakyIcon marks flaky jobs in the jobs pane
+const FlakyIcon = "↯"
+
+// attemptJobs are the jobs of one attempt of a run
+type attemptJobs struct {
+	Run     github.Run
+	Attempt int
+	Jobs    []github.Job
+}
+
+// flakyFailure is a failed attempt of a job that succeeded for the same commit
+type flakyFailure struct {
+	RunNumber int
+	Attempt   int
+	HeadSHA   string
+	URL       string
+}
+
+// flakyJob is a job that is flaky on some of the analysed commits
+type flakyJob struct {
+	Name        string
+	FlakySHAs   int
+	Rate        float64        // FlakySHAs over the commits analysed
+	Failures    []flakyFailure // Newest first
+	lastFailure int            // Run number of the newest failure, to break ties
+}
+
+// flakyState is the flaky jobs of a workflow, once detected
+type flakyState struct {
+	jobs    []flakyJob
+	names   map[string]bool
+	shas    int          // Commits analysed
+	pending []github.Run // Candidate runs whose jobs are not fetched yet
+	err     error
+	loading bool
+}
+
+// flakyDialog is the state of the flaky jobs list
+type flakyDialog struct {
+	workflowID int64
+	name       string
+	cursor     int
+}
+
+// flakyRow is a line of the flaky jobs list: a job, or one of its failures
+type flakyRow struct {
+	job     *flakyJob
+	failure *flakyFailure
+}
+
+// flakyCandidates returns the runs that can reveal flaky jobs: rerun runs and
+// runs sharing their head SHA with another run, newest first, capped at FlakyMaxRuns
+func flakyCandidates(runs []github.Run) []github.Run {
+	perSHA := make(map[string]int)
+	for _, run := range runs {
+		if run.Status == "completed" {
+			perSHA[run.HeadSHA]++
+		}
+	}
+	var candidates []github.Run
+	for _, run := range runs {
+		if run.Status == "completed" && (run.Attempt > 1 || perSHA[run.HeadSHA] > 1) {
+			candidates = append(candidates, run)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt.After(candidates[j].CreatedAt)
+	})
+	if len(candidates) > FlakyMaxRuns {
+		candidates = candidates[:FlakyMaxRuns]
+	}
+	return candidates
+}
+
+// distinctSHAs counts the commits of completed runs
+func distinctSHAs(runs []github.Run) int {
+	shas := make(map[string]bool)
+	for _, run := range runs {
+		if run.Status == "completed" {
+			shas[run.HeadSHA] = true
+		}
+	}
+	return len(shas)
+}
+
+// fetchFlakyJobs creates a command to fetch the jobs of every attempt of runs,
+// StatsConcurrency requests at a time.
+// It captures the client, repo, and workflowID to avoid race conditions.
+func fetchFlakyJobs(ctx context.Context, client github.Client, repo github.Repository, workflowID int64, runs []github.Run, shas int) tea.Cmd {
+	return func() tea.Msg {
+		var results []attemptJobs
+		for _, run := range runs {
+			for attempt := 1; attempt <= max(run.Attempt, 1); attempt++ {
+				results = append(results, attemptJobs{Run: run, Attempt: attempt})
+			}
+		}
+		errs := make([]error, len(results))
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, StatsConcurrency)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				r := &results[i]
+				r.Jobs, errs[i] = client.ListJobsForAttempt(ctx, repo, r.Run.ID, r.Attempt)
+			}()
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				return FlakyJobsLoadedMsg{WorkflowID: workflowID, Err: err}
+			}
+		}
+		return FlakyJobsLoadedMsg{WorkflowID: workflowID, Attempts: results, SHAs: shas}
+	}
+}
+
+// computeFlaky ranks jobs by flake rate: the share of the shas commits
+// on which the job both failed and succeeded
+func computeFlaky(attempts []attemptJobs, shas int) []flakyJob {
+	type outcome struct {
+		succeeded bool
+		failures  []flakyFailure
+	}
+	byJob := make(map[string]map[string]*outcome) // Job name -> head SHA -> outcome
+	for _, a := range attempts {
+		for _, job := range a.Jobs {
+			if byJob[job.Name] == nil {
+				byJob[job.Name] = make(map[string]*outcome)
+			}
+			o := byJob[job.Name][a.Run.HeadSHA]
+			if o == nil {
+				o = &outcome{}
+				byJob[job.Name][a.Run.HeadSHA] = o
+			}
+			switch {
+			case job.Conclusion == "success":
+				o.succeeded = true
+			case isFailure(job.Conclusion):
+				url := job.URL
+				if url == "" && a.Run.URL != "" {
+					url = a.Run.URL + "/attempts/" + strconv.Itoa(a.Attempt)
+				}
+				o.failures = append(o.failures, flakyFailure{
+					RunNumber: a.Run.RunNumber,
+					Attempt:   a.Attempt,
+					HeadSHA:   a.Run.HeadSHA,
+					URL:       url,
+				})
+			}
+		}
+	}
+
+	var jobs []flakyJob
+	for name, perSHA := range byJob {
+		job := flakyJob{Name: name}
+		for _, o := range perSHA {
+			if !o.succeeded || len(o.failures) == 0 {
+				continue
+			}
+			job.FlakySHAs++
+			job.Failures = append(job.Failures, o.failures...)
+		}
+		if job.FlakySHAs == 0 {
+			continue
+		}
+		if shas > 0 {
+			job.Rate = float64(job.FlakySHAs) / float64(shas)
+		}
+		sort.Slice(job.Failures, func(i, j int) bool {
+			fi, fj := job.Failures[i], job.Failures[j]
+			if fi.RunNumber != fj.RunNumber {
+				return fi.RunNumber > fj.RunNumber
+			}
+			return fi.Attempt > fj.Attempt
+		})
+		job.lastFailure = job.Failures[0].RunNumber
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Rate != jobs[j].Rate {
+			return jobs[i].Rate > jobs[j].Rate
+		}
+		if jobs[i].lastFailure != jobs[j].lastFailure {
+			return jobs[i].lastFailure > jobs[j].lastFailure
+		}
+		return jobs[i].Name < jobs[j].Name
+	})
+	return jobs
+}
+
+// detectFlakyCmd starts detecting the flaky jobs of a workflow from its recent runs.
+// The jobs of the candidate runs are only fetched while the Info tab or the flaky
+// jobs list is shown; until then the candidates are kept, along with the flaky
+// jobs found last time.
+func (a *App) detectFlakyCmd(workflowID int64, runs []github.Run) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	candidates := flakyCandidates(runs)
+	shas := distinctSHAs(runs)
+	if len(candidates) == 0 {
+		a.flaky[workflowID] = flakyState{shas: shas}
+		return nil
+	}
+	if a.detailTab != InfoTab && a.flakyList == nil {
+		state := a.flaky[workflowID]
+		state.pending, state.shas = candidates, shas
+		a.flaky[workflowID] = state
+		return nil
+	}
+	a.cancelFlakyDetection()
+	a.flaky[workflowID] = flakyState{pending: candidates, shas: shas, loading: true}
+	ctx := newRequestContext(&a.cancelFlaky)
+	return fetchFlakyJobs(ctx, a.client, a.repo, workflowID, candidates, shas)
+}
+
+// fetchFlakyCmd fetches the jobs of the candidate runs of the selected workflow
+// that were kept until the Info tab or the flaky jobs list is shown
+func (a *App) fetchFlakyCmd() tea.Cmd {
+	if a.detailTab != InfoTab && a.flakyList == nil {
+		return nil
+	}
+	wf, ok := a.workflows.Selected()
+	if !ok {
+		return nil
+	}
+	if state := a.flaky[wf.ID]; len(state.pending) == 0 || state.loading {
+		return nil
+	}
+	a.cancelFlakyDetection()
+	state := a.flaky[wf.ID]
+	state.loading = true
+	a.flaky[wf.ID] = state
+	ctx := newRequestContext(&a.cancelFlaky)
+	return fetchFlakyJobs(ctx, a.client, a.repo, wf.ID, state.pending, state.shas)
+}
+
+// cancelFlakyDetection cancels the running flaky jobs detection. Its candidate
+// runs stay pending, to be fetched again when the workflow is shown again.
+func (a *App) cancelFlakyDetection() {
+	cancelRequest(&a.cancelFlaky)
+	for id, state := range a.flaky {
+		if state.loading {
+			state.loading = false
+			a.flaky[id] = state
+		}
+	}
+}
+
+// handleFlakyJobsLoaded ranks and caches the flaky jobs of a workflow.
+// A cancelled detection kept its candidates pending (see cancelFlakyDetection),
+// and is made again if the workflow is selected again meanwhile.
+func (a *App) handleFlakyJobsLoaded(msg FlakyJobsLoadedMsg) tea.Cmd {
+	if isCancelled(msg.Err) {
+		return a.fetchFlakyCmd()
+	}
+	if msg.Err != nil {
+		a.flaky[msg.WorkflowID] = flakyState{err: msg.Err}
+		return nil
+	}
+	jobs := computeFlaky(msg.Attempts, msg.SHAs)
+	names := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		names[job.Name] = true
+	}
+	a.flaky[msg.WorkflowID] = flakyState{jobs: jobs, names: names, shas: msg.SHAs}
+	return nil
+}
+
+// isFlakyJob returns true if the job is flaky in the selected workflow
+func (a *App) isFlakyJob(name string) bool {
+	wf, ok := a.workflows.Selected()
+	if !ok {
+		return false
+	}
+	return a.flaky[wf.ID].names[name]
+}
+
+// showFlakyJobs opens the flaky jobs list of the selected workflow
+func (a *App) showFlakyJobs() tea.Cmd {
+	wf, ok := a.workflows.Selected()
+	if !ok {
+		return nil
+	}
+	a.flakyList = &flakyDialog{workflowID: wf.ID, name: wf.Name}
+	return tea.Batch(a.fetchStatsCmd(), a.fetchFlakyCmd())
+}
+
+// flakyRows returns the lines of the flaky jobs list
+func (a *App) flakyRows() []flakyRow {
+	state := a.flaky[a.flakyList.workflowID]
+	var rows []flakyRow
+	for i := range state.jobs {
+		job := &state.jobs[i]
+		rows = append(rows, flakyRow{job: job})
+		for j := range job.Failures {
+			rows = append(rows, flakyRow{job: job, failure: &job.Failures[j]})
+		}
+	}
+	return rows
+}
+
+// handleFlakyInput handles input when in the flaky jobs list
+func (a *App) handleFlakyInput(msg tea.KeyMsg) tea.Cmd {
+	d := a.flakyList
+	rows := a.flakyRows()
+
+	switch msg.String() {
+	case "up", "k":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case "down", "j":
+		if d.cursor < len(rows)-1 {
+			d.cursor++
+		}
+	case "y":
+		if d.cursor >= len(rows) {
+			return nil
+		}
+		// A job row links to its newest failure
+		row := rows[d.cursor]
+		failure := row.failure
+		if failure == nil {
+			failure = &row.job.Failures[0]
+		}
+		if failure.URL == "" {
+			return nil
+		}
+		return a.copyURL(failure.URL)
+	case "esc", "q", "F":
+		a.flakyList = nil
+	}
+	return nil
+}
+
+// renderFlakyDialog renders the flaky jobs list
+func (a *App) renderFlakyDialog() string {
+	d := a.flakyList
+	state, known := a.flaky[d.workflowID]
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render("Flaky jobs - " + d.name), ""}
+	switch {
+	case !known || state.loading:
+		lines = append(lines, "Analysing recent runs...")
+	case state.err != nil:
+		lines = append(lines, "Unavailable: "+state.err.Error())
+	case len(state.jobs) == 0:
+		lines = append(lines, fmt.Sprintf("No flaky jobs in the last %d commits", state.shas))
+	default:
+		lines = append(lines, fmt.Sprintf("Jobs that failed and passed on the same commit (last %d commits)", state.shas), "")
+		for i, row := range a.flakyRows() {
+			cursor := "  "
+			if i == d.cursor {
+				cursor = CursorStyle.Render(">") + " "
+			}
+			var text string
+			if row.failure == nil {
+				text = fmt.Sprintf("%4.0f%%  %s  %s", row.job.Rate*100,
+					FlakyStyle.Render(FlakyIcon)+" "+row.job.Name,
+					QueuedStyle.Render(fmt.Sprintf("(%d of %d commits)", row.job.FlakySHAs, state.shas)))
+			} else {
+				f := row.failure
+				text = fmt.Sprintf("        %s #%d attempt %d  %s", FailureStyle.Render("✗"), f.RunNumber, f.Attempt, shortSHA(f.HeadSHA))
+			}
+			lines = append(lines, cursor+text)
+		}
+	}
+	lines = append(lines, "", "[j/k] move  [y] copy link to failed attempt  [esc] close")
+
+	dialog := FlakyDialog.Width(72).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height,
+		lipgloss.Center, lipgloss.Center, dialog)
+}
+
+// shortSHA returns the first 7 characters of a commit SHA
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func flakyRun(id int64, number int, sha string, attempt int) github.Run {
+	return github.Run{
+		ID:         id,
+		RunNumber:  number,
+		HeadSHA:    sha,
+		Attempt:    attempt,
+		Status:     "completed",
+		Conclusion: "success",
+		CreatedAt:  time.Date(2026, 1, 1, number, 0, 0, 0, time.UTC),
+		URL:        "https://github.com/owner/repo/actions/runs/" + strconv.FormatInt(id, 10),
+	}
+}
+
+func TestFlakyCandidates(t *testing.T) {
+	runs := []github.Run{
+		flakyRun(1, 1, "aaa", 1),
+		flakyRun(2, 2, "bbb", 2), // Rerun
+		flakyRun(3, 3, "ccc", 1), // Same SHA as 4
+		flakyRun(4, 4, "ccc", 1),
+		{ID: 5, HeadSHA: "ccc", Attempt: 3, Status: "in_progress"},
+	}
+
+	got := flakyCandidates(runs)
+	if len(got) != 3 || got[0].ID != 4 || got[1].ID != 3 || got[2].ID != 2 {
+		t.Errorf("flakyCandidates() = %v, want runs 4, 3, 2", got)
+	}
+	if n := distinctSHAs(runs); n != 3 {
+		t.Errorf("distinctSHAs() = %d, want 3", n)
+	}
+}
+
+func TestComputeFlaky(t *testing.T) {
+	rerun := flakyRun(2, 12, "bbb", 2)
+	first, second := flakyRun(3, 13, "ccc", 1), flakyRun(4, 14, "ccc", 1)
+	attempts := []attemptJobs{
+		// "test" failed then passed on rerun of bbb
+		{Run: rerun, Attempt: 1, Jobs: []github.Job{
+			{Name: "test", Conclusion: "failure", URL: "https://example.com/job/21"},
+			{Name: "lint", Conclusion: "success"},
+		}},
+		{Run: rerun, Attempt: 2, Jobs: []github.Job{{Name: "test", Conclusion: "success"}}},
+		// "test" and "build" failed on one run of ccc and passed on another
+		{Run: first, Attempt: 1, Jobs: []github.Job{
+			{Name: "test", Conclusion: "timed_out"},
+			{Name: "build", Conclusion: "failure"},
+		}},
+		{Run: second, Attempt: 1, Jobs: []github.Job{
+			{Name: "test", Conclusion: "success"},
+			{Name: "build", Conclusion: "success"},
+		}},
+		// "deploy" always fails: broken, not flaky
+		{Run: second, Attempt: 1, Jobs: []github.Job{{Name: "deploy", Conclusion: "failure"}}},
+	}
+
+	jobs := computeFlaky(attempts, 10)
+
+	if len(jobs) != 2 {
+		t.Fatalf("computeFlaky() = %+v, want test and build", jobs)
+	}
+	test := jobs[0]
+	if test.Name != "test" || test.FlakySHAs != 2 || test.Rate != 0.2 {
+		t.Errorf("jobs[0] = %+v, want test flaky on 2 of 10 commits", test)
+	}
+	if len(test.Failures) != 2 || test.Failures[0].RunNumber != 13 || test.Failures[1].URL != "https://example.com/job/21" {
+		t.Errorf("test failures = %+v, want newest first with job links", test.Failures)
+	}
+	if jobs[1].Name != "build" || jobs[1].Failures[0].URL != first.URL+"/attempts/1" {
+		t.Errorf("jobs[1] = %+v, want build linking to the failed attempt", jobs[1])
+	}
+}
+
+func TestApp_FlakyJobs(t *testing.T) {
+	runs := []github.Run{flakyRun(2, 12, "bbb", 2), flakyRun(1, 11, "aaa", 1)}
+	mock := newMockClient(&mockClientState{runs: runs, defaultBranch: "main"})
+	mock.ListJobsForAttemptFunc = func(_ context.Context, _ github.Repository, runID int64, attempt int) ([]github.Job, error) {
+		conclusion := "success"
+		if attempt == 1 {
+			conclusion = "failure"
+		}
+		return []github.Job{{Name: "test", Conclusion: conclusion, URL: "https://example.com/job/1"}}, nil
+	}
+	cb := &mockClipboard{}
+	app := New(WithClient(mock), WithClipboard(cb))
+	app.width = 120
+	app.height = 40
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+	app.jobs.SetItems([]github.Job{{Name: "test", Status: "completed", Conclusion: "success"}})
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	if app.flakyList == nil {
+		t.Fatal("flaky list should be open")
+	}
+	if view := app.View(); !strings.Contains(view, "Analysing recent runs") {
+		t.Errorf("flaky list should show progress:\n%s", view)
+	}
+
+	// Statistics, then flaky jobs from the same runs
+	for _, msg := range runCmds(cmd) {
+		_, next := app.Update(msg)
+		for _, msg := range runCmds(next) {
+			app.Update(msg)
+		}
+	}
+	if calls := mock.ListJobsForAttemptCalls(); len(calls) != 2 {
+		t.Errorf("ListJobsForAttempt called %d times, want both attempts of the rerun", len(calls))
+	}
+
+	view := app.View()
+	for _, want := range []string{"50%", "test", "(1 of 2 commits)", "#12 attempt 1", "bbb"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("flaky list should contain %q:\n%s", want, view)
+		}
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cb.content != "https://example.com/job/1" {
+		t.Errorf("copied %q, want the failed job link", cb.content)
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.flakyList != nil {
+		t.Fatal("Esc should close the flaky list")
+	}
+	if !strings.Contains(app.View(), FlakyIcon) {
+		t.Error("jobs pane should mark the flaky job")
+	}
+}
+
+func TestApp_FlakyJobs_OnlyInInfoTab(t *testing.T) {
+	runs := []github.Run{flakyRun(2, 12, "bbb", 2), flakyRun(1, 11, "aaa", 1)}
+	mock := newMockClient(&mockClientState{runs: runs, defaultBranch: "main"})
+	app := New(WithClient(mock))
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+
+	// Statistics loaded after leaving the Info tab
+	app.detailTab = InfoTab
+	stats := app.fetchStatsCmd()
+	app.detailTab = LogsTab
+	for _, msg := range runCmds(stats) {
+		_, next := app.Update(msg)
+		if len(runCmds(next)) != 0 {
+			t.Error("flaky jobs should not be detected outside the Info tab")
+		}
+	}
+	if calls := mock.ListJobsForAttemptCalls(); len(calls) != 0 {
+		t.Fatalf("ListJobsForAttempt called %d times, want none", len(calls))
+	}
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+	if calls := mock.ListJobsForAttemptCalls(); len(calls) != 2 {
+		t.Errorf("ListJobsForAttempt called %d times, want both attempts once the Info tab is shown", len(calls))
+	}
+}
+
+func TestApp_FlakyJobs_CancelledOnWorkflowChange(t *testing.T) {
+	runs := []github.Run{flakyRun(2, 12, "bbb", 2), flakyRun(1, 11, "aaa", 1)}
+	mock := newMockClient(&mockClientState{runs: runs, defaultBranch: "main"})
+	app := New(WithClient(mock))
+	app.detailTab = InfoTab
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}, {ID: 2, Name: "Deploy"}})
+
+	mock.ListJobsForAttemptFunc = func(ctx context.Context, _ github.Repository, _ int64, _ int) ([]github.Job, error) {
+		return nil, ctx.Err()
+	}
+	app.workflows.SelectNext()
+	app.workflowStats[2] = statsState{stats: &workflowStats{}, fetchedAt: time.Now()}
+	detect := app.detectFlakyCmd(2, runs)
+	app.workflows.SelectPrev()
+	app.onWorkflowSelectionChange()
+
+	msg := detect().(FlakyJobsLoadedMsg)
+	if !isCancelled(msg.Err) {
+		t.Fatalf("err = %v, want the request cancelled", msg.Err)
+	}
+	app.Update(msg)
+	if state := app.flaky[2]; state.loading || len(state.pending) != 1 {
+		t.Errorf("flaky state = %+v, want the candidates pending", state)
+	}
+	if _, ok := app.workflowStats[2]; !ok {
+		t.Error("statistics should be kept")
+	}
+
+	// Coming back fetches the jobs of the pending candidates only
+	mock.ListJobsForAttemptFunc = func(_ context.Context, _ github.Repository, _ int64, _ int) ([]github.Job, error) {
+		return nil, nil
+	}
+	app.workflows.SelectNext()
+	for _, msg := range runCmds(app.onWorkflowSelectionChange()) {
+		app.Update(msg)
+	}
+	if state := app.flaky[2]; state.loading || len(state.pending) != 0 {
+		t.Errorf("flaky state = %+v, want the detection done", state)
+	}
+	for _, call := range mock.ListRunsCalls() {
+		if call.Opts.PerPage == StatsPageSize {
+			t.Error("statistics should be reused")
+		}
+	}
+}
@@ -0,0 +1,681 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+	"gopkg.in/yaml.v3"
+)
+
+// Job graph - the jobs of the workflow file laid out by their needs, with the
+// selected run's jobs mapped onto them, shown in the Graph tab
+
+// minGraphLabel is the narrowest a node label is truncated to
+const minGraphLabel = 6
+
+var errNoJobs = errors.New("no jobs in the workflow file")
+
+// jobNode is a job of the workflow file, with the jobs of the run it ran as
+// (several for a matrix or a reusable workflow call)
+type jobNode struct {
+	id      string
+	name    string // Name, or ID when unnamed
+	needs   []string
+	pattern *regexp.Regexp // Matches the names of the run's jobs
+	jobs    []github.Job
+	level   int
+}
+
+// graphCell is a node in a column of the layout, or a dummy carrying
+// the edges of a node to a later column
+type graphCell struct {
+	node  *jobNode // nil for a dummy
+	index int      // File order, to break ties
+	preds []*graphCell
+	row   int
+}
+
+// graphHit is where a node is drawn, for clicks
+type graphHit struct {
+	line     int
+	from, to int // Display columns, end exclusive
+	node     *jobNode
+}
+
+// Directions of a grid point in the edges between columns
+const (
+	edgeUp uint8 = 1 << iota
+	edgeDown
+	edgeLeft
+	edgeRight
+)
+
+// edgeRunes draws a grid point from the directions it connects
+var edgeRunes = map[uint8]rune{
+	edgeLeft: '─', edgeRight: '─', edgeLeft | edgeRight: '─',
+	edgeUp: '│', edgeDown: '│', edgeUp | edgeDown: '│',
+	edgeDown | edgeRight: '┌', edgeDown | edgeLeft: '┐', edgeUp | edgeRight: '└', edgeUp | edgeLeft: '┘',
+	edgeUp | edgeDown | edgeRight: '├', edgeUp | edgeDown | edgeLeft: '┤',
+	edgeLeft | edgeRight | edgeDown: '┬', edgeLeft | edgeRight | edgeUp: '┴',
+	edgeUp | edgeDown | edgeLeft | edgeRight: '┼',
+}
+
+// yamlValue returns the value of key in a mapping node, or nil
+func yamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// parseJobGraph returns the jobs of a workflow file in file order
+func parseJobGraph(content string) ([]*jobNode, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, errNoJobs
+	}
+	jobs := yamlValue(doc.Content[0], "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode || len(jobs.Content) == 0 {
+		return nil, errNoJobs
+	}
+
+	var nodes []*jobNode
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		def := jobs.Content[i+1]
+		n := &jobNode{id: jobs.Content[i].Value, name: jobs.Content[i].Value}
+		if name := yamlValue(def, "name"); name != nil && name.Kind == yaml.ScalarNode && name.Value != "" {
+			n.name = name.Value
+		}
+		switch needs := yamlValue(def, "needs"); {
+		case needs == nil:
+		case needs.Kind == yaml.ScalarNode:
+			n.needs = []string{needs.Value}
+		case needs.Kind == yaml.SequenceNode:
+			for _, need := range needs.Content {
+				n.needs = append(n.needs, need.Value)
+			}
+		}
+		n.pattern = jobNamePattern(n.name)
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// jobNamePattern matches the names GitHub gives the jobs of a workflow job:
+// its name with expressions evaluated, followed by the values of a matrix
+// ("test (ubuntu, 1.22)") and the jobs of a called workflow ("deploy / push")
+func jobNamePattern(name string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	rest := name
+	for {
+		start := strings.Index(rest, "${{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(rest[start:], "}}")
+		if end < 0 {
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(rest[:start]) + ".*")
+		rest = rest[start+end+2:]
+	}
+	b.WriteString(regexp.QuoteMeta(rest) + `(?: \(.*\))?(?: / .*)?$`)
+	return regexp.MustCompile(b.String())
+}
+
+// mapJobs assigns the run's jobs to the nodes they ran as, preferring exact
+// names, and returns the jobs that match no node
+func mapJobs(nodes []*jobNode, jobs []github.Job) []github.Job {
+	var unmatched []github.Job
+	for _, job := range jobs {
+		node := matchNode(nodes, job.Name)
+		if node == nil {
+			unmatched = append(unmatched, job)
+			continue
+		}
+		node.jobs = append(node.jobs, job)
+	}
+	return unmatched
+}
+
+// matchNode returns the node a job named name ran as, or nil
+func matchNode(nodes []*jobNode, name string) *jobNode {
+	for _, n := range nodes {
+		if n.name == name {
+			return n
+		}
+	}
+	for _, n := range nodes {
+		if n.pattern.MatchString(name) {
+			return n
+		}
+	}
+	return nil
+}
+
+// assignLevels places every node one level after the deepest job it needs.
+// Unknown needs are ignored, and a cycle is cut where it is found.
+func assignLevels(nodes []*jobNode) {
+	byID := make(map[string]*jobNode, len(nodes))
+	for _, n := range nodes {
+		byID[n.id] = n
+	}
+	const (
+		visiting = iota + 1
+		done
+	)
+	state := make(map[*jobNode]int, len(nodes))
+	var level func(n *jobNode) int
+	level = func(n *jobNode) int {
+		switch state[n] {
+		case visiting:
+			return -1
+		case done:
+			return n.level
+		}
+		state[n] = visiting
+		n.level = 0
+		for _, need := range n.needs {
+			if p, ok := byID[need]; ok {
+				n.level = max(n.level, level(p)+1)
+			}
+		}
+		state[n] = done
+		return n.level
+	}
+	for _, n := range nodes {
+		level(n)
+	}
+}
+
+// layoutGraph places the nodes in columns by level. Edges spanning several
+// columns go through dummies, shared by the edges of the same node, and each
+// column is ordered by the average row of the cells feeding it.
+func layoutGraph(nodes []*jobNode) [][]*graphCell {
+	assignLevels(nodes)
+	levels := 0
+	byID := make(map[string]*jobNode, len(nodes))
+	for _, n := range nodes {
+		levels = max(levels, n.level+1)
+		byID[n.id] = n
+	}
+
+	columns := make([][]*graphCell, levels)
+	cells := make(map[*jobNode]*graphCell, len(nodes))
+	for i, n := range nodes {
+		cells[n] = &graphCell{node: n, index: i}
+		columns[n.level] = append(columns[n.level], cells[n])
+	}
+	type dummyKey struct {
+		from  *jobNode
+		level int
+	}
+	dummies := make(map[dummyKey]*graphCell)
+	for _, n := range nodes {
+		for _, need := range n.needs {
+			p, ok := byID[need]
+			if !ok || p.level >= n.level {
+				continue
+			}
+			prev := cells[p]
+			for l := p.level + 1; l < n.level; l++ {
+				d, ok := dummies[dummyKey{p, l}]
+				if !ok {
+					d = &graphCell{index: cells[n].index, preds: []*graphCell{prev}}
+					dummies[dummyKey{p, l}] = d
+					columns[l] = append(columns[l], d)
+				}
+				prev = d
+			}
+			cells[n].preds = append(cells[n].preds, prev)
+		}
+	}
+
+	for c, column := range columns {
+		center := make(map[*graphCell]float64, len(column))
+		for _, cell := range column {
+			center[cell] = float64(cell.index)
+			if c > 0 && len(cell.preds) > 0 {
+				sum := 0
+				for _, p := range cell.preds {
+					sum += p.row
+				}
+				center[cell] = float64(sum) / float64(len(cell.preds))
+			}
+		}
+		sort.SliceStable(column, func(i, j int) bool {
+			if center[column[i]] != center[column[j]] {
+				return center[column[i]] < center[column[j]]
+			}
+			return column[i].index < column[j].index
+		})
+		for row, cell := range column {
+			cell.row = row
+		}
+	}
+	return columns
+}
+
+// nodeStatus returns the combined status and conclusion of a node's jobs:
+// running while any runs, failed if any failed
+func nodeStatus(n *jobNode) (string, string) {
+	if len(n.jobs) == 0 {
+		return "", ""
+	}
+	status, conclusion := "completed", n.jobs[0].Conclusion
+	for _, job := range n.jobs {
+		switch {
+		case job.Status != "completed":
+			return job.Status, ""
+		case isFailure(job.Conclusion):
+			conclusion = "failure"
+		case job.Conclusion == "cancelled" && conclusion != "failure":
+			conclusion = "cancelled"
+		}
+	}
+	return status, conclusion
+}
+
+// nodeIcon returns the status icon of a node; jobs that did not run are dimmed
+func nodeIcon(n *jobNode) string {
+	if len(n.jobs) == 0 {
+		return DisabledItem.Render("·")
+	}
+	if icon := StatusIcon(nodeStatus(n)); icon != " " {
+		return icon
+	}
+	return DisabledItem.Render("-")
+}
+
+// nodeLabel returns the text of a node: the name of the job it ran as, or its
+// name with the number of jobs it ran as
+func nodeLabel(n *jobNode) string {
+	switch len(n.jobs) {
+	case 0:
+		return n.name
+	case 1:
+		return n.jobs[0].Name
+	}
+	return fmt.Sprintf("%s ×%d", n.name, len(n.jobs))
+}
+
+// gapWidth returns the width of the edges drawn after a column: a lane per
+// cell of the next column that has edges, and the ends of the lanes
+func gapWidth(next []*graphCell) int {
+	lanes := 0
+	for _, cell := range next {
+		if len(cell.preds) > 0 {
+			lanes++
+		}
+	}
+	return 2*lanes + 1
+}
+
+// orderLanes returns the cells of next with edges in the order of their lanes.
+// The edges of a cell leave on its row, where they must not run into the
+// lane of the cell of next on the same row, so that lane comes after them.
+func orderLanes(next []*graphCell) []*graphCell {
+	var dests []*graphCell
+	destAt := make(map[int]*graphCell)
+	targets := make(map[*graphCell][]*graphCell)
+	for _, cell := range next {
+		if len(cell.preds) == 0 {
+			continue
+		}
+		dests = append(dests, cell)
+		destAt[cell.row] = cell
+		for _, p := range cell.preds {
+			targets[p] = append(targets[p], cell)
+		}
+	}
+	after := make(map[*graphCell]map[*graphCell]bool)
+	for source, ts := range targets {
+		d, ok := destAt[source.row]
+		if !ok {
+			continue
+		}
+		for _, t := range ts {
+			if t != d {
+				if after[d] == nil {
+					after[d] = make(map[*graphCell]bool)
+				}
+				after[d][t] = true
+			}
+		}
+	}
+
+	// Topological order, by row among the lanes that are free to go next;
+	// a cycle is broken at its first row
+	var lanes []*graphCell
+	placed := make(map[*graphCell]bool)
+	for len(lanes) < len(dests) {
+		var next *graphCell
+		for _, d := range dests {
+			if placed[d] {
+				continue
+			}
+			free := true
+			for t := range after[d] {
+				free = free && placed[t]
+			}
+			if free {
+				next = d
+				break
+			}
+		}
+		if next == nil {
+			for _, d := range dests {
+				if !placed[d] {
+					next = d
+					break
+				}
+			}
+		}
+		placed[next] = true
+		lanes = append(lanes, next)
+	}
+	return lanes
+}
+
+// drawGap returns the lines of the edges into the cells of next, on a grid
+// where row r of a column is on line 2r
+func drawGap(next []*graphCell, lines, width int) []string {
+	grid := make([][]uint8, lines)
+	for y := range grid {
+		grid[y] = make([]uint8, width)
+	}
+	hline := func(y, x1, x2 int) {
+		for x := x1; x <= x2; x++ {
+			if x > x1 {
+				grid[y][x] |= edgeLeft
+			}
+			if x < x2 {
+				grid[y][x] |= edgeRight
+			}
+		}
+	}
+	vline := func(x, y1, y2 int) {
+		if y1 > y2 {
+			y1, y2 = y2, y1
+		}
+		for y := y1; y <= y2; y++ {
+			if y > y1 {
+				grid[y][x] |= edgeUp
+			}
+			if y < y2 {
+				grid[y][x] |= edgeDown
+			}
+		}
+	}
+
+	for lane, cell := range orderLanes(next) {
+		x, to := 1+2*lane, 2*cell.row
+		for _, p := range cell.preds {
+			from := 2 * p.row
+			grid[from][0] |= edgeLeft
+			hline(from, 0, x)
+			vline(x, from, to)
+		}
+		hline(to, x, width-1)
+		grid[to][width-1] |= edgeRight
+	}
+
+	out := make([]string, lines)
+	for y, row := range grid {
+		var b strings.Builder
+		for _, mask := range row {
+			if r, ok := edgeRunes[mask]; ok {
+				b.WriteRune(r)
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		out[y] = b.String()
+	}
+	return out
+}
+
+// renderJobGraph draws the nodes as columns of labels joined by edges, left
+// to right. It returns the lines, where each node is, and the line of the
+// node of the selected job (-1 if none).
+func renderJobGraph(nodes []*jobNode, selectedJobID int64, maxWidth int) ([]string, []graphHit, int) {
+	columns := layoutGraph(nodes)
+	rows := 0
+	for _, column := range columns {
+		rows = max(rows, len(column))
+	}
+	lines := 2*rows - 1
+
+	// Labels share the width left by the edges
+	gaps := make([][]string, len(columns))
+	edges := 0
+	for c := range columns[:len(columns)-1] {
+		width := gapWidth(columns[c+1])
+		gaps[c] = drawGap(columns[c+1], lines, width)
+		edges += width
+	}
+	labelCap := max((maxWidth-edges)/len(columns), minGraphLabel)
+
+	out := make([]string, lines)
+	var hits []graphHit
+	selectedLine := -1
+	x := 0
+	for c, column := range columns {
+		width := 0
+		for _, cell := range column {
+			if cell.node != nil {
+				width = max(width, 3+len([]rune(nodeLabel(cell.node))))
+			}
+		}
+		width = min(max(width, 1), labelCap)
+
+		cellAt := make(map[int]*graphCell, len(column))
+		for _, cell := range column {
+			cellAt[2*cell.row] = cell
+		}
+		for y := range out {
+			cell, ok := cellAt[y]
+			switch {
+			case !ok:
+				out[y] += strings.Repeat(" ", width)
+			case cell.node == nil:
+				out[y] += strings.Repeat("─", width)
+			default:
+				selected := false
+				for _, job := range cell.node.jobs {
+					selected = selected || job.ID == selectedJobID
+				}
+				label := padRight(truncateString(nodeLabel(cell.node), width-2), width-2)
+				switch {
+				case selected:
+					label = SelectedItemFocused.Render(label)
+					selectedLine = y
+				case len(cell.node.jobs) == 0:
+					label = DisabledItem.Render(label)
+				}
+				out[y] += nodeIcon(cell.node) + " " + label
+				hits = append(hits, graphHit{line: y, from: x, to: x + width, node: cell.node})
+			}
+			if c < len(columns)-1 {
+				out[y] += gaps[c][y]
+			}
+		}
+		x += width
+		if c < len(columns)-1 {
+			x += len([]rune(gaps[c][0]))
+		}
+	}
+	return out, hits, selectedLine
+}
+
+// jobGraphView lays out the Graph tab: the lines above the graph, the graph
+// with where its nodes are, the line of the selected job's node, and the
+// lines below it
+func (a *App) jobGraphView(maxWidth int) (header, graph []string, hits []graphHit, selectedLine int, footer []string) {
+	key, ok := a.definitionKeyFor()
+	if !ok {
+		return []string{"  Select a workflow"}, nil, nil, -1, nil
+	}
+	header = []string{a.definitionTitle(key, maxWidth)}
+
+	state, known := a.definitions[key]
+	switch {
+	case !known || state.loading:
+		return append(header, "", "  Loading..."), nil, nil, -1, nil
+	case state.err != nil:
+		return append(header, "", "  Unavailable: "+state.err.Error()), nil, nil, -1, nil
+	}
+	nodes, err := parseJobGraph(state.content)
+	if err != nil {
+		return append(header, "", "  Unavailable: "+err.Error()), nil, nil, -1, nil
+	}
+	header = append(header, "  "+QueuedStyle.Render("click a job or use h/j/k/l in the jobs pane to select it"), "")
+
+	var jobs []github.Job
+	if _, ok := a.runs.Selected(); ok {
+		jobs = a.jobs.Items()
+	}
+	unmatched := mapJobs(nodes, jobs)
+	var selectedID int64
+	if job, ok := a.jobs.Selected(); ok {
+		selectedID = job.ID
+	}
+	graph, hits, selectedLine = renderJobGraph(nodes, selectedID, maxWidth-2)
+	for i := range graph {
+		graph[i] = "  " + graph[i]
+	}
+
+	if len(unmatched) > 0 {
+		names := make([]string, len(unmatched))
+		for i, job := range unmatched {
+			names[i] = job.Name
+		}
+		footer = []string{"", "  " + truncateString("Not in the workflow file: "+strings.Join(names, ", "), maxWidth-2)}
+	}
+	return header, graph, hits, selectedLine, footer
+}
+
+// graphOffset returns the first graph line shown in height lines, keeping
+// the selected node in view
+func graphOffset(lines, selectedLine, height int) int {
+	if selectedLine < height || height <= 0 {
+		return 0
+	}
+	return max(min(selectedLine-height/2, lines-height), 0)
+}
+
+// graphHeight returns the number of graph lines the Graph tab has room for
+func (a *App) graphHeight(header, footer []string) int {
+	totalHeight, _ := a.panelLayout()
+	return totalHeight - BorderWidth - len(header) - len(footer)
+}
+
+// buildGraphContent builds the content of the Graph tab
+func (a *App) buildGraphContent(maxWidth int) []string {
+	header, graph, _, selectedLine, footer := a.jobGraphView(maxWidth)
+	offset := graphOffset(len(graph), selectedLine, a.graphHeight(header, footer))
+	content := append(header, graph[offset:]...)
+	return append(content, footer...)
+}
+
+// clickGraph selects the job of the node at a position of the Graph tab content
+func (a *App) clickGraph(x, line int) tea.Cmd {
+	header, graph, hits, selectedLine, footer := a.jobGraphView(a.width - a.leftPanelWidth() - ContentPadding)
+	offset := graphOffset(len(graph), selectedLine, a.graphHeight(header, footer))
+	line += offset - len(header)
+	x -= 2 // Indentation
+	for _, hit := range hits {
+		if hit.line == line && x >= hit.from && x < hit.to && len(hit.node.jobs) > 0 {
+			return a.selectGraphNode(hit.node)
+		}
+	}
+	return nil
+}
+
+// selectGraphNode selects the first job of node in the jobs pane
+func (a *App) selectGraphNode(node *jobNode) tea.Cmd {
+	for i, job := range a.jobs.Items() {
+		if job.ID == node.jobs[0].ID {
+			a.jobs.Select(i)
+			a.focusedPane = JobsPane
+			return a.onJobSelectionChange()
+		}
+	}
+	return nil
+}
+
+// handleGraphKey moves between the nodes of the Graph tab with h/j/k/l,
+// returning false for other keys
+func (a *App) handleGraphKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	var dx, dy int
+	switch msg.String() {
+	case "h":
+		dx = -1
+	case "l":
+		dx = 1
+	case "k":
+		dy = -1
+	case "j":
+		dy = 1
+	default:
+		return nil, false
+	}
+	_, _, hits, _, _ := a.jobGraphView(a.width - a.leftPanelWidth() - ContentPadding)
+	var selectedID int64
+	if job, ok := a.jobs.Selected(); ok {
+		selectedID = job.ID
+	}
+	if next, ok := nextGraphHit(hits, selectedID, dx, dy); ok {
+		return a.selectGraphNode(next.node), true
+	}
+	return nil, true
+}
+
+// nextGraphHit returns the node next to the node of the selected job in a
+// direction: the nearest column left or right, preferring the nearest line,
+// or the nearest line up or down, preferring the same column. Nodes without
+// jobs are skipped. Without a selected node, the first node is returned.
+func nextGraphHit(hits []graphHit, selectedJobID int64, dx, dy int) (graphHit, bool) {
+	var current *graphHit
+	for i, hit := range hits {
+		for _, job := range hit.node.jobs {
+			if job.ID == selectedJobID {
+				current = &hits[i]
+			}
+		}
+	}
+
+	var best graphHit
+	bestScore := [2]int{-1, -1}
+	for _, hit := range hits {
+		if len(hit.node.jobs) == 0 {
+			continue
+		}
+		if current == nil {
+			return hit, true
+		}
+		cols, lines := hit.from-current.from, hit.line-current.line
+		if cols*dx <= 0 && lines*dy <= 0 {
+			continue
+		}
+		score := [2]int{max(cols, -cols), max(lines, -lines)}
+		if bestScore[0] < 0 || score[0] < bestScore[0] || (score[0] == bestScore[0] && score[1] < bestScore[1]) {
+			best, bestScore = hit, score
+		}
+	}
+	return best, bestScore[0] >= 0
+}
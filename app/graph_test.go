@@ -0,0 +1,291 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+const graphWorkflow = `name: CI
+on: push
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps: [{run: make lint}]
+  build:
+    runs-on: ubuntu-latest
+    strategy:
+      matrix:
+        os: [linux, windows]
+    steps: [{run: make}]
+  test:
+    needs: build
+    runs-on: ubuntu-latest
+    steps: [{run: make test}]
+  deploy:
+    name: Deploy ${{ inputs.env }}
+    needs: [test, lint]
+    uses: ./.github/workflows/deploy.yml
+`
+
+func TestParseJobGraph(t *testing.T) {
+	nodes, err := parseJobGraph(graphWorkflow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, n := range nodes {
+		ids = append(ids, n.id)
+	}
+	if got := strings.Join(ids, ","); got != "lint,build,test,deploy" {
+		t.Errorf("nodes = %s, want file order", got)
+	}
+	if nodes[2].needs[0] != "build" || len(nodes[3].needs) != 2 {
+		t.Errorf("needs = %v, %v", nodes[2].needs, nodes[3].needs)
+	}
+
+	if _, err := parseJobGraph("on: push\n"); err != errNoJobs {
+		t.Errorf("error = %v, want errNoJobs", err)
+	}
+	if _, err := parseJobGraph("jobs: [\n"); err == nil {
+		t.Error("invalid YAML should fail")
+	}
+}
+
+func TestMapJobs(t *testing.T) {
+	nodes, _ := parseJobGraph(graphWorkflow)
+	unmatched := mapJobs(nodes, []github.Job{
+		{ID: 1, Name: "lint"},
+		{ID: 2, Name: "build (linux)"},
+		{ID: 3, Name: "build (windows)"},
+		{ID: 4, Name: "test"},
+		{ID: 5, Name: "Deploy staging / push"},
+		{ID: 6, Name: "Deploy staging / smoke"},
+		{ID: 7, Name: "dynamic"},
+	})
+
+	want := map[string][]int64{"lint": {1}, "build": {2, 3}, "test": {4}, "deploy": {5, 6}}
+	for _, n := range nodes {
+		var ids []int64
+		for _, job := range n.jobs {
+			ids = append(ids, job.ID)
+		}
+		if len(ids) != len(want[n.id]) {
+			t.Errorf("%s ran as %v, want %v", n.id, ids, want[n.id])
+			continue
+		}
+		for i := range ids {
+			if ids[i] != want[n.id][i] {
+				t.Errorf("%s ran as %v, want %v", n.id, ids, want[n.id])
+			}
+		}
+	}
+	if len(unmatched) != 1 || unmatched[0].Name != "dynamic" {
+		t.Errorf("unmatched = %v, want the job missing from the file", unmatched)
+	}
+}
+
+func TestNodeStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		jobs           []github.Job
+		wantStatus     string
+		wantConclusion string
+	}{
+		{"not run", nil, "", ""},
+		{"success", []github.Job{{Status: "completed", Conclusion: "success"}}, "completed", "success"},
+		{"one failed", []github.Job{{Status: "completed", Conclusion: "success"}, {Status: "completed", Conclusion: "failure"}}, "completed", "failure"},
+		{"one running", []github.Job{{Status: "completed", Conclusion: "failure"}, {Status: "in_progress"}}, "in_progress", ""},
+		{"skipped", []github.Job{{Status: "completed", Conclusion: "skipped"}}, "completed", "skipped"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, conclusion := nodeStatus(&jobNode{jobs: tt.jobs})
+			if status != tt.wantStatus || conclusion != tt.wantConclusion {
+				t.Errorf("nodeStatus() = %q, %q, want %q, %q", status, conclusion, tt.wantStatus, tt.wantConclusion)
+			}
+		})
+	}
+}
+
+func TestAssignLevels_Cycle(t *testing.T) {
+	nodes := []*jobNode{{id: "a", needs: []string{"b"}}, {id: "b", needs: []string{"a"}}, {id: "c", needs: []string{"b", "missing"}}}
+	assignLevels(nodes)
+	if nodes[0].level != 1 || nodes[1].level != 0 || nodes[2].level != 1 {
+		t.Errorf("levels = %d, %d, %d, want the cycle cut", nodes[0].level, nodes[1].level, nodes[2].level)
+	}
+}
+
+func TestRenderJobGraph(t *testing.T) {
+	nodes, _ := parseJobGraph(graphWorkflow)
+	mapJobs(nodes, []github.Job{{ID: 4, Name: "test", Status: "completed", Conclusion: "success"}})
+
+	lines, hits, selected := renderJobGraph(nodes, 4, 80)
+	var got []string
+	for _, line := range lines {
+		got = append(got, strings.TrimRight(line, " "))
+	}
+	want := []string{
+		"· lint  ─────────────┬─· Deploy ${{ inputs.env ...",
+		"                     │",
+		"· build ─────✓ test ─┘",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("graph =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if selected != 2 {
+		t.Errorf("selected line = %d, want the line of test", selected)
+	}
+	if len(hits) != 4 || hits[2].node.id != "test" || hits[2].line != 2 || hits[2].from != 13 {
+		t.Errorf("hits = %+v", hits)
+	}
+}
+
+func TestDrawGap_CrossingEdges(t *testing.T) {
+	// a feeds d and b feeds c: the edge of a would run into c on its row,
+	// so the lane of c comes after the lane of d
+	a, b := &graphCell{row: 0}, &graphCell{row: 2}
+	next := []*graphCell{{row: 0, preds: []*graphCell{b}}, {row: 1, preds: []*graphCell{a}}}
+
+	got := strings.Join(drawGap(next, 5, gapWidth(next)), "\n")
+	want := strings.Join([]string{
+		"─┐ ┌─",
+		" │ │ ",
+		" └─┼─",
+		"   │ ",
+		"───┘ ",
+	}, "\n")
+	if got != want {
+		t.Errorf("gap =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func newGraphTestApp() *App {
+	state := &mockClientState{files: map[string]string{testWorkflowPath + "@abc1234def": graphWorkflow}}
+	app, _ := newDefinitionTestApp(state, nil)
+	app.jobs.SetItems([]github.Job{
+		{ID: 1, Name: "lint", Status: "completed", Conclusion: "success"},
+		{ID: 2, Name: "build (linux)", Status: "completed", Conclusion: "failure"},
+		{ID: 3, Name: "build (windows)", Status: "completed", Conclusion: "success"},
+	})
+	return app
+}
+
+func TestApp_GraphTab(t *testing.T) {
+	app := newGraphTestApp()
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
+	if app.detailTab != GraphTab {
+		t.Fatal("4 should show the Graph tab")
+	}
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+
+	view := app.View()
+	for _, want := range []string{"[4] Graph", testWorkflowPath + " @ abc1234 (run #7)", "✓ lint", "✗ build ×2", "· test"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Graph tab should contain %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestApp_GraphTab_ClickSelectsJob(t *testing.T) {
+	app := newGraphTestApp()
+	app.detailTab = GraphTab
+	for _, msg := range runCmds(app.fetchDefinitionCmd()) {
+		app.Update(msg)
+	}
+	header, _, hits, _, _ := app.jobGraphView(app.width - app.leftPanelWidth() - ContentPadding)
+
+	// Click the build node: border, then the indentation of the graph
+	var build graphHit
+	for _, hit := range hits {
+		if hit.node.id == "build" {
+			build = hit
+		}
+	}
+	x := app.leftPanelWidth() + 1 + 2 + build.from
+	y := 1 + len(header) + build.line
+	app.handleMouseEvent(tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionRelease})
+
+	if job, _ := app.jobs.Selected(); job.ID != 2 {
+		t.Errorf("selected job = %d, want the first build job", job.ID)
+	}
+	if app.focusedPane != JobsPane {
+		t.Errorf("focusedPane = %v, want JobsPane", app.focusedPane)
+	}
+
+	// Jobs that did not run cannot be selected
+	for _, hit := range hits {
+		if hit.node.id == "test" {
+			app.clickGraph(2+hit.from, len(header)+hit.line)
+		}
+	}
+	if job, _ := app.jobs.Selected(); job.ID != 2 {
+		t.Errorf("selected job = %d, want it unchanged", job.ID)
+	}
+}
+
+func TestApp_GraphTab_KeysSelectJobs(t *testing.T) {
+	app := newGraphTestApp()
+	app.jobs.SetItems(append(app.jobs.Items(),
+		github.Job{ID: 4, Name: "test", Status: "completed", Conclusion: "success"},
+		github.Job{ID: 5, Name: "Deploy prod / push", Status: "in_progress"},
+	))
+	app.detailTab = GraphTab
+	app.focusedPane = JobsPane
+	for _, msg := range runCmds(app.fetchDefinitionCmd()) {
+		app.Update(msg)
+	}
+
+	// lint and build share the first column, test and deploy follow
+	steps := []struct {
+		key  rune
+		want int64
+	}{
+		{'j', 2}, // build, below lint
+		{'l', 4}, // test, right of build
+		{'l', 5}, // deploy
+		{'l', 5}, // nothing further right
+		{'h', 4},
+		{'k', 5}, // deploy, the nearest node above test
+		{'h', 4},
+		{'h', 2}, // build, on the line of test
+		{'k', 1},
+	}
+	for _, step := range steps {
+		prev, _ := app.jobs.Selected()
+		app.parsedLogs = &ParsedLogs{}
+		app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{step.key}})
+		job, _ := app.jobs.Selected()
+		if job.ID != step.want {
+			t.Fatalf("after %c selected job = %d, want %d", step.key, job.ID, step.want)
+		}
+		if app.focusedPane != JobsPane || app.detailTab != GraphTab {
+			t.Fatalf("%c should stay in the Graph tab of the jobs pane", step.key)
+		}
+		// Like a click, a new selection goes through onJobSelectionChange
+		if job.ID != prev.ID && app.parsedLogs != nil {
+			t.Errorf("after %c the logs of the previous job should be reset", step.key)
+		}
+	}
+}
+
+func TestGraphOffset(t *testing.T) {
+	tests := []struct {
+		lines, selected, height, want int
+	}{
+		{10, -1, 5, 0},
+		{10, 3, 5, 0},
+		{10, 6, 4, 4},
+		{10, 9, 4, 6},
+	}
+	for _, tt := range tests {
+		if got := graphOffset(tt.lines, tt.selected, tt.height); got != tt.want {
+			t.Errorf("graphOffset(%d, %d, %d) = %d, want %d", tt.lines, tt.selected, tt.height, got, tt.want)
+		}
+	}
+}
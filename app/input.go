@@ -17,6 +17,43 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return a.handleConfirmInput(msg)
 	}
 
+	// Handle delete dialog
+	if a.deleting != nil {
+		return a.handleDeleteInput(msg)
+	}
+
+	// Handle save logs dialog
+	if a.savingLogs != nil {
+		return a.handleSaveLogsInput(msg)
+	}
+
+	// Handle yank menu
+	if a.yanking != nil {
+		return a.handleYankInput(msg)
+	}
+
+	// Handle deployment review dialog
+	if a.review != nil {
+		return a.handleReviewInput(msg)
+	}
+
+	// Handle flaky jobs list
+	if a.flakyList != nil {
+		return a.handleFlakyInput(msg)
+	}
+
+	// Handle log diff
+	if a.logDiff != nil {
+		return a.handleLogDiffInput(msg)
+	}
+
+	// In the Graph tab, h/j/k/l move between the jobs of the graph
+	if a.detailTab == GraphTab && a.focusedPane == JobsPane {
+		if cmd, ok := a.handleGraphKey(msg); ok {
+			return cmd
+		}
+	}
+
 	switch {
 	case key.Matches(msg, a.keys.Quit):
 		return tea.Quit
@@ -29,9 +66,17 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 			a.showHelp = false
 		} else if a.fullscreenLog {
 			a.fullscreenLog = false
+		} else if a.logView.Selecting() {
+			a.logView.ClearSelection()
 		} else if a.detailTab == LogsTab && a.focusedPane == JobsPane && !a.stepListFocused {
 			// Return focus to step list from log content
 			a.stepListFocused = true
+		} else if a.runs.InVisual() {
+			a.runs.EndVisual()
+		} else if a.runs.MarkedCount() > 0 {
+			a.runs.ClearMarks()
+		} else if a.offline {
+			return a.reconnectNow()
 		} else if a.err != nil {
 			a.err = nil
 			return a.refreshAll()
@@ -70,16 +115,63 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 			a.fullscreenLog = true
 		}
 
+	case key.Matches(msg, a.keys.Pager):
+		if a.focusedPane == JobsPane {
+			return a.openLogs(pagerViewer)
+		}
+
+	case key.Matches(msg, a.keys.Editor):
+		if a.focusedPane == JobsPane {
+			return a.openLogs(editorViewer)
+		}
+
+	case key.Matches(msg, a.keys.SaveLogs):
+		if a.focusedPane == JobsPane {
+			return a.confirmSaveLogs()
+		}
+
 	case key.Matches(msg, a.keys.Cancel):
 		if a.focusedPane == RunsPane {
 			return a.confirmCancelRun()
 		}
 
-	case key.Matches(msg, a.keys.Rerun):
+	case key.Matches(msg, a.keys.ForceCancel):
 		if a.focusedPane == RunsPane {
+			return a.confirmForceCancelRun()
+		}
+
+	case key.Matches(msg, a.keys.PrevAttempt):
+		if a.focusedPane == RunsPane || a.focusedPane == JobsPane {
+			return a.cycleAttempt(-1)
+		}
+
+	case key.Matches(msg, a.keys.NextAttempt):
+		if a.focusedPane == RunsPane || a.focusedPane == JobsPane {
+			return a.cycleAttempt(1)
+		}
+
+	case key.Matches(msg, a.keys.FlakyJobs):
+		return a.showFlakyJobs()
+
+	case key.Matches(msg, a.keys.Compare):
+		switch a.focusedPane {
+		case RunsPane:
+			return a.toggleCompareBase()
+		case JobsPane:
+			return a.compareJobLogs()
+		}
+
+	case key.Matches(msg, a.keys.Rerun):
+		switch a.focusedPane {
+		case RunsPane:
 			return a.rerunWorkflow()
+		case JobsPane:
+			return a.rerunJob(nil)
 		}
 
+	case key.Matches(msg, a.keys.RerunDebug):
+		return a.rerunWithDebug()
+
 	case key.Matches(msg, a.keys.RerunFailed):
 		if a.focusedPane == RunsPane {
 			return a.rerunFailedJobs()
@@ -90,17 +182,105 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 			return a.triggerWorkflow()
 		}
 
+	case key.Matches(msg, a.keys.ToggleWorkflow):
+		if a.focusedPane == WorkflowsPane {
+			return a.confirmToggleWorkflow()
+		}
+
+	case key.Matches(msg, a.keys.HideDisabled):
+		if a.focusedPane == WorkflowsPane {
+			return a.toggleHideDisabled()
+		}
+
 	case key.Matches(msg, a.keys.Yank):
-		return a.yankURL()
+		if a.logView.Selecting() && a.logContentFocused() {
+			return a.copySelectedLogLines()
+		}
+		return a.openYankMenu()
+
+	case key.Matches(msg, a.keys.Open):
+		return a.openInBrowser()
+
+	case key.Matches(msg, a.keys.OpenPR):
+		return a.openPullRequest()
+
+	case key.Matches(msg, a.keys.Mark):
+		if a.focusedPane == RunsPane {
+			a.runs.ToggleMark()
+		}
+
+	case key.Matches(msg, a.keys.Visual):
+		if a.focusedPane == RunsPane {
+			a.toggleVisual()
+		} else if a.logContentFocused() && a.parsedLogs != nil {
+			a.toggleLogSelection()
+		}
+
+	case key.Matches(msg, a.keys.MarkAll):
+		if a.focusedPane == RunsPane {
+			a.runs.MarkAllFiltered()
+		}
+
+	case key.Matches(msg, a.keys.Delete):
+		if a.focusedPane == RunsPane {
+			return a.confirmDeleteRun()
+		}
+
+	case key.Matches(msg, a.keys.DeleteLogs):
+		if a.focusedPane == RunsPane {
+			return a.confirmDeleteRunLogs()
+		}
+
+	case key.Matches(msg, a.keys.DeleteAll):
+		if a.focusedPane == RunsPane {
+			return a.confirmDeleteFilteredRuns()
+		}
+
+	case key.Matches(msg, a.keys.Review):
+		if a.focusedPane == RunsPane {
+			return a.reviewDeployments()
+		}
+
+	case key.Matches(msg, a.keys.Watch):
+		if a.focusedPane == RunsPane {
+			return a.toggleWatchRun()
+		}
+
+	case key.Matches(msg, a.keys.WatchBranch):
+		return a.toggleWatchBranch()
 
 	case key.Matches(msg, a.keys.Refresh):
-		return a.refreshAll()
+		// Workflow usage and statistics change with every run, so fetch them again,
+		// and the working copy of workflow definitions may have been edited
+		clear(a.workflowUsage)
+		clear(a.workflowStats)
+		clear(a.definitions)
+		return tea.Batch(a.refreshAll(), a.fetchDefinitionCmd(), a.fetchLintCmd())
 
 	case key.Matches(msg, a.keys.InfoTab):
 		a.detailTab = InfoTab
+		return tea.Batch(a.fetchUsageCmd(), a.fetchStatsCmd(), a.fetchFlakyCmd())
 
 	case key.Matches(msg, a.keys.LogsTab):
 		a.detailTab = LogsTab
+
+	case key.Matches(msg, a.keys.WorkflowTab):
+		a.detailTab = WorkflowTab
+		return a.fetchDefinitionCmd()
+
+	case key.Matches(msg, a.keys.GraphTab):
+		a.detailTab = GraphTab
+		return a.fetchDefinitionCmd()
+
+	case key.Matches(msg, a.keys.PageUp):
+		if a.detailTab == WorkflowTab {
+			a.scrollDefinition(-a.logPaneHeight() / 2)
+		}
+
+	case key.Matches(msg, a.keys.PageDown):
+		if a.detailTab == WorkflowTab {
+			a.scrollDefinition(a.logPaneHeight() / 2)
+		}
 	}
 
 	return nil
@@ -167,9 +347,13 @@ func (a *App) navigateUp() tea.Cmd {
 			a.navigateStepUp()
 			return nil
 		}
-		// If not step focused, scroll log content
+		// If not step focused, extend the line selection or scroll log content
 		if a.detailTab == LogsTab && !a.stepListFocused {
-			a.logView.ScrollUp()
+			if a.logView.Selecting() {
+				a.logView.MoveCursor(-1)
+			} else {
+				a.logView.ScrollUp()
+			}
 			return nil
 		}
 		a.jobs.SelectPrev()
@@ -193,9 +377,13 @@ func (a *App) navigateDown() tea.Cmd {
 			a.navigateStepDown()
 			return nil
 		}
-		// If not step focused, scroll log content
+		// If not step focused, extend the line selection or scroll log content
 		if a.detailTab == LogsTab && !a.stepListFocused {
-			a.logView.ScrollDown()
+			if a.logView.Selecting() {
+				a.logView.MoveCursor(1)
+			} else {
+				a.logView.ScrollDown()
+			}
 			return nil
 		}
 		a.jobs.SelectNext()
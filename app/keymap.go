@@ -4,28 +4,54 @@ import "github.com/charmbracelet/bubbles/key"
 
 // KeyMap defines all keybindings for the application
 type KeyMap struct {
-	Up          key.Binding
-	Down        key.Binding
-	Left        key.Binding
-	Right       key.Binding
-	PanelUp     key.Binding
-	PanelDown   key.Binding
-	Tab         key.Binding
-	ShiftTab    key.Binding
-	Enter       key.Binding
-	Trigger     key.Binding
-	Cancel      key.Binding
-	Rerun       key.Binding
-	RerunFailed key.Binding
-	Yank        key.Binding
-	Filter      key.Binding
-	Refresh     key.Binding
-	FullLog     key.Binding
-	Help        key.Binding
-	Quit        key.Binding
-	Escape      key.Binding
-	InfoTab     key.Binding
-	LogsTab     key.Binding
+	Up             key.Binding
+	Down           key.Binding
+	Left           key.Binding
+	Right          key.Binding
+	PanelUp        key.Binding
+	PanelDown      key.Binding
+	Tab            key.Binding
+	ShiftTab       key.Binding
+	Enter          key.Binding
+	Trigger        key.Binding
+	ToggleWorkflow key.Binding
+	HideDisabled   key.Binding
+	Cancel         key.Binding
+	ForceCancel    key.Binding
+	Rerun          key.Binding
+	PrevAttempt    key.Binding
+	FlakyJobs      key.Binding
+	Compare        key.Binding
+	NextAttempt    key.Binding
+	RerunFailed    key.Binding
+	RerunDebug     key.Binding
+	Yank           key.Binding
+	Open           key.Binding
+	OpenPR         key.Binding
+	Mark           key.Binding
+	Visual         key.Binding
+	MarkAll        key.Binding
+	Delete         key.Binding
+	DeleteLogs     key.Binding
+	DeleteAll      key.Binding
+	Review         key.Binding
+	Watch          key.Binding
+	WatchBranch    key.Binding
+	Filter         key.Binding
+	Refresh        key.Binding
+	FullLog        key.Binding
+	Pager          key.Binding
+	Editor         key.Binding
+	SaveLogs       key.Binding
+	Help           key.Binding
+	Quit           key.Binding
+	Escape         key.Binding
+	InfoTab        key.Binding
+	LogsTab        key.Binding
+	WorkflowTab    key.Binding
+	GraphTab       key.Binding
+	PageUp         key.Binding
+	PageDown       key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings
@@ -71,10 +97,38 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("t"),
 			key.WithHelp("t", "trigger workflow"),
 		),
+		ToggleWorkflow: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "enable/disable workflow"),
+		),
+		HideDisabled: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "hide disabled workflows"),
+		),
 		Cancel: key.NewBinding(
 			key.WithKeys("c"),
 			key.WithHelp("c", "cancel run"),
 		),
+		ForceCancel: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "force-cancel stuck run"),
+		),
+		FlakyJobs: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "flaky jobs"),
+		),
+		Compare: key.NewBinding(
+			key.WithKeys("="),
+			key.WithHelp("=", "compare job logs between runs"),
+		),
+		PrevAttempt: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous run attempt"),
+		),
+		NextAttempt: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next run attempt"),
+		),
 		Rerun: key.NewBinding(
 			key.WithKeys("r"),
 			key.WithHelp("r", "rerun workflow"),
@@ -83,10 +137,58 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("R"),
 			key.WithHelp("R", "rerun failed jobs"),
 		),
+		RerunDebug: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "rerun with debug logging"),
+		),
 		Yank: key.NewBinding(
 			key.WithKeys("y"),
 			key.WithHelp("y", "copy to clipboard"),
 		),
+		Open: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open in browser"),
+		),
+		OpenPR: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "open pull request"),
+		),
+		Mark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "mark item"),
+		),
+		Visual: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "visual range select"),
+		),
+		MarkAll: key.NewBinding(
+			key.WithKeys("ctrl+a"),
+			key.WithHelp("ctrl+a", "mark all filtered"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "delete run"),
+		),
+		DeleteLogs: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "purge run logs"),
+		),
+		DeleteAll: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "delete filtered runs"),
+		),
+		Review: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "review deployments"),
+		),
+		Watch: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "watch run"),
+		),
+		WatchBranch: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "watch my branch"),
+		),
 		Filter: key.NewBinding(
 			key.WithKeys("/"),
 			key.WithHelp("/", "filter"),
@@ -99,6 +201,18 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("L"),
 			key.WithHelp("L", "full log view"),
 		),
+		Pager: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "open logs in pager"),
+		),
+		Editor: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "open logs in editor"),
+		),
+		SaveLogs: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "save logs to file"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "help"),
@@ -119,5 +233,21 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("2"),
 			key.WithHelp("2", "logs tab"),
 		),
+		WorkflowTab: key.NewBinding(
+			key.WithKeys("3"),
+			key.WithHelp("3", "workflow tab"),
+		),
+		GraphTab: key.NewBinding(
+			key.WithKeys("4"),
+			key.WithHelp("4", "graph tab"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup", "ctrl+u"),
+			key.WithHelp("pgup", "scroll workflow up"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown", "ctrl+d"),
+			key.WithHelp("pgdown", "scroll workflow down"),
+		),
 	}
 }
@@ -78,9 +78,35 @@ func TestDefaultKeyMap_ActionKeys(t *testing.T) {
 		{"Rerun", km.Rerun, []string{"r"}},
 		{"RerunFailed", km.RerunFailed, []string{"R"}},
 		{"Yank", km.Yank, []string{"y"}},
+		{"ForceCancel", km.ForceCancel, []string{"C"}},
+		{"FlakyJobs", km.FlakyJobs, []string{"F"}},
+		{"Compare", km.Compare, []string{"="}},
+		{"WorkflowTab", km.WorkflowTab, []string{"3"}},
+		{"GraphTab", km.GraphTab, []string{"4"}},
+		{"Open", km.Open, []string{"o"}},
+		{"OpenPR", km.OpenPR, []string{"O"}},
+		{"PageUp", km.PageUp, []string{"pgup", "ctrl+u"}},
+		{"PageDown", km.PageDown, []string{"pgdown", "ctrl+d"}},
+		{"PrevAttempt", km.PrevAttempt, []string{"["}},
+		{"NextAttempt", km.NextAttempt, []string{"]"}},
+		{"RerunDebug", km.RerunDebug, []string{"b"}},
+		{"Mark", km.Mark, []string{" "}},
+		{"Visual", km.Visual, []string{"v"}},
+		{"MarkAll", km.MarkAll, []string{"ctrl+a"}},
+		{"Delete", km.Delete, []string{"d"}},
+		{"DeleteLogs", km.DeleteLogs, []string{"P"}},
+		{"DeleteAll", km.DeleteAll, []string{"D"}},
+		{"Review", km.Review, []string{"a"}},
+		{"ToggleWorkflow", km.ToggleWorkflow, []string{"e"}},
+		{"HideDisabled", km.HideDisabled, []string{"H"}},
+		{"Watch", km.Watch, []string{"w"}},
+		{"WatchBranch", km.WatchBranch, []string{"W"}},
 		{"Filter", km.Filter, []string{"/"}},
 		{"Refresh", km.Refresh, []string{"ctrl+r"}},
 		{"FullLog", km.FullLog, []string{"L"}},
+		{"Pager", km.Pager, []string{"p"}},
+		{"Editor", km.Editor, []string{"E"}},
+		{"SaveLogs", km.SaveLogs, []string{"s"}},
 		{"Help", km.Help, []string{"?"}},
 		{"Quit", km.Quit, []string{"q"}},
 		{"Escape", km.Escape, []string{"esc"}},
@@ -130,6 +156,9 @@ func TestDefaultKeyMap_HelpText(t *testing.T) {
 		{"Filter", km.Filter, "/", "filter"},
 		{"Refresh", km.Refresh, "ctrl+r", "refresh"},
 		{"FullLog", km.FullLog, "L", "full log view"},
+		{"Pager", km.Pager, "p", "open logs in pager"},
+		{"Editor", km.Editor, "E", "open logs in editor"},
+		{"SaveLogs", km.SaveLogs, "s", "save logs to file"},
 		{"Help", km.Help, "?", "help"},
 		{"Quit", km.Quit, "q", "quit"},
 		{"Escape", km.Escape, "esc", "back/cancel"},
@@ -172,6 +201,9 @@ func TestDefaultKeyMap_AllBindingsEnabled(t *testing.T) {
 		{"Filter", km.Filter},
 		{"Refresh", km.Refresh},
 		{"FullLog", km.FullLog},
+		{"Pager", km.Pager},
+		{"Editor", km.Editor},
+		{"SaveLogs", km.SaveLogs},
 		{"Help", km.Help},
 		{"Quit", km.Quit},
 		{"Escape", km.Escape},
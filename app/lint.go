@@ -0,0 +1,77 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/lint"
+)
+
+// Workflow lint - problems in the local workflow files, shown in the Info tab
+// of the workflows pane
+
+// lintState holds the lint results of the local workflow files, by path
+type lintState struct {
+	issues  map[string][]lint.Issue
+	err     error
+	loading bool
+}
+
+// fetchLintCmd lints the workflow files of the local clone, if there is one
+func (a *App) fetchLintCmd() tea.Cmd {
+	if a.local == nil {
+		return nil
+	}
+	a.workflowLint = lintState{loading: true}
+	return lintWorkflows(a.local)
+}
+
+// lintWorkflows creates a command to lint the workflow files of a local clone.
+// It captures the local repository to avoid race conditions.
+func lintWorkflows(local LocalRepo) tea.Cmd {
+	return func() tea.Msg {
+		root, err := local.Root()
+		if err != nil {
+			return LintLoadedMsg{Err: err}
+		}
+		issues, err := lint.Dir(root)
+		return LintLoadedMsg{Issues: issues, Err: err}
+	}
+}
+
+// handleLintLoaded stores lint results by workflow path
+func (a *App) handleLintLoaded(msg LintLoadedMsg) {
+	if msg.Err != nil {
+		a.workflowLint = lintState{err: msg.Err}
+		return
+	}
+	byPath := make(map[string][]lint.Issue)
+	for _, issue := range msg.Issues {
+		byPath[issue.Path] = append(byPath[issue.Path], issue)
+	}
+	a.workflowLint = lintState{issues: byPath}
+}
+
+// buildLintContent builds the lint results of a workflow file for the Info tab
+func (a *App) buildLintContent(path string, maxWidth int) []string {
+	if a.local == nil || path == "" {
+		return nil
+	}
+	content := []string{"", "  Lint (working copy)"}
+	switch {
+	case a.workflowLint.loading:
+		return append(content, "    Loading...")
+	case a.workflowLint.err != nil:
+		return append(content, "    Unavailable: "+a.workflowLint.err.Error())
+	}
+
+	issues := a.workflowLint.issues[path]
+	if len(issues) == 0 {
+		return append(content, "    "+SuccessStyle.Render("✓")+" No problems")
+	}
+	for _, issue := range issues {
+		text := fmt.Sprintf("%d:%d %s (%s)", issue.Line, issue.Column, issue.Message, issue.Rule)
+		content = append(content, "    "+FailureStyle.Render("✗")+" "+truncateString(text, maxWidth-8))
+	}
+	return content
+}
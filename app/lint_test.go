@@ -0,0 +1,90 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// writeWorkflow writes a workflow file into a new repository root
+func writeWorkflow(t *testing.T, content string) string {
+	t.Helper()
+	root := t.TempDir()
+	path := filepath.Join(root, filepath.FromSlash(testWorkflowPath))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestApp_Lint_InfoTab(t *testing.T) {
+	root := writeWorkflow(t, "on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n    needs: test\n    steps:\n      - run: make\n")
+	app, _ := newDefinitionTestApp(nil, &mockLocalRepo{root: root})
+	app.detailTab = InfoTab
+
+	for _, msg := range runCmds(app.fetchLintCmd()) {
+		app.Update(msg)
+	}
+	view := app.View()
+	if !strings.Contains(view, "Lint (working copy)") || !strings.Contains(view, `5:12 job "build" needs unknown job "test" (needs)`) {
+		t.Errorf("Info tab should list the lint problems:\n%s", view)
+	}
+
+	// Other workflows have no problems
+	app.workflows.SetItems([]github.Workflow{{ID: 2, Name: "Deploy", Path: ".github/workflows/deploy.yml"}})
+	if view := app.View(); !strings.Contains(view, "No problems") {
+		t.Errorf("Info tab should report no problems:\n%s", view)
+	}
+}
+
+func TestApp_Lint_RefreshRelints(t *testing.T) {
+	root := writeWorkflow(t, "on: push\njobs: {}\n")
+	app, _ := newDefinitionTestApp(nil, &mockLocalRepo{root: root})
+	for _, msg := range runCmds(app.fetchLintCmd()) {
+		app.Update(msg)
+	}
+	if got := len(app.workflowLint.issues[testWorkflowPath]); got != 0 {
+		t.Fatalf("issues = %d, want none", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, filepath.FromSlash(testWorkflowPath)), []byte("on: [push\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyCtrlR})
+	for _, msg := range runCmds(cmd) {
+		if msg, ok := msg.(LintLoadedMsg); ok {
+			app.Update(msg)
+		}
+	}
+	if got := app.workflowLint.issues[testWorkflowPath]; len(got) != 1 || got[0].Rule != "syntax" {
+		t.Errorf("issues = %v, want the syntax error after refresh", got)
+	}
+}
+
+func TestApp_Lint_Unavailable(t *testing.T) {
+	app, _ := newDefinitionTestApp(nil, &mockLocalRepo{})
+	app.detailTab = InfoTab
+	for _, msg := range runCmds(app.fetchLintCmd()) {
+		app.Update(msg)
+	}
+	if view := app.View(); !strings.Contains(view, "Unavailable: not a git repository") {
+		t.Errorf("Info tab should report why linting failed:\n%s", view)
+	}
+
+	// Without a local clone there is nothing to lint
+	app, _ = newDefinitionTestApp(nil, nil)
+	app.detailTab = InfoTab
+	if cmd := app.fetchLintCmd(); cmd != nil {
+		t.Error("nothing should be linted without a local clone")
+	}
+	if view := app.View(); strings.Contains(view, "Lint") {
+		t.Errorf("Info tab should not show lint results without a local clone:\n%s", view)
+	}
+}
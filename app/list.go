@@ -7,6 +7,9 @@ import (
 // FilteredList is a generic thread-safe list with filtering capability.
 // It follows the lazydocker FilteredList[T] pattern for filterable lists
 // used in TUI applications.
+//
+// Items can also be marked for bulk actions. Marks and the selection are
+// tracked by the key returned by keyFn, so they survive SetItems and filter changes.
 type FilteredList[T any] struct {
 	mu          sync.RWMutex
 	allItems    []T
@@ -14,6 +17,10 @@ type FilteredList[T any] struct {
 	filter      string
 	selectedIdx int
 	matchFn     func(item T, filter string) bool
+
+	keyFn        func(item T) any
+	marked       map[any]bool
+	visualAnchor any // Key of the item where visual range selection started, nil when inactive
 }
 
 // NewFilteredList creates a new FilteredList with the provided match function.
@@ -28,21 +35,48 @@ func NewFilteredList[T any](matchFn func(T, string) bool) *FilteredList[T] {
 		filtered:    make([]T, 0),
 		matchFn:     matchFn,
 		selectedIdx: 0,
+		marked:      make(map[any]bool),
 	}
 }
 
+// SetKeyFunc sets the function identifying items for marking and for keeping
+// the selection when items are replaced.
+// Marking does nothing until a key function is set.
+func (l *FilteredList[T]) SetKeyFunc(keyFn func(T) any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.keyFn = keyFn
+}
+
 // SetItems sets the items in the list and applies the current filter.
-// This replaces any existing items.
+// This replaces any existing items. With a key function, the selected item
+// stays selected if it is still in the list, wherever it moved to.
 func (l *FilteredList[T]) SetItems(items []T) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	var selectedKey any
+	if l.keyFn != nil && len(l.filtered) > 0 {
+		selectedKey = l.keyFn(l.filtered[l.selectedIdx])
+	}
+
 	if items == nil {
 		l.allItems = make([]T, 0)
 	} else {
 		l.allItems = items
 	}
 	l.applyFilter()
+	l.pruneMarks()
+
+	if selectedKey != nil {
+		for i, item := range l.filtered {
+			if l.keyFn(item) == selectedKey {
+				l.selectedIdx = i
+				break
+			}
+		}
+	}
 }
 
 // SetFilter sets the filter string and refilters the items.
@@ -90,6 +124,20 @@ func (l *FilteredList[T]) clampSelectedIndex() {
 	}
 }
 
+// Matching returns the items of items matching the current filter.
+func (l *FilteredList[T]) Matching(items []T) []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var matching []T
+	for _, item := range items {
+		if l.filter == "" || l.matchFn(item, l.filter) {
+			matching = append(matching, item)
+		}
+	}
+	return matching
+}
+
 // Items returns the filtered items.
 // Returns an empty slice (not nil) if there are no items.
 func (l *FilteredList[T]) Items() []T {
@@ -170,12 +218,179 @@ func (l *FilteredList[T]) Select(idx int) {
 	}
 }
 
-// Reset clears the filter and resets the selection to the first item.
+// Reset clears the filter, ends visual range selection and resets the selection to the first item.
 func (l *FilteredList[T]) Reset() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
 	l.filter = ""
 	l.selectedIdx = 0
+	l.visualAnchor = nil
 	l.applyFilter()
 }
+
+// pruneMarks forgets marks of items that are no longer in the list.
+// Must be called with the lock held.
+func (l *FilteredList[T]) pruneMarks() {
+	if len(l.marked) == 0 || l.keyFn == nil {
+		return
+	}
+	present := make(map[any]bool, len(l.allItems))
+	for _, item := range l.allItems {
+		present[l.keyFn(item)] = true
+	}
+	for key := range l.marked {
+		if !present[key] {
+			delete(l.marked, key)
+		}
+	}
+}
+
+// ToggleMark marks or unmarks the selected item.
+func (l *FilteredList[T]) ToggleMark() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.keyFn == nil || len(l.filtered) == 0 {
+		return
+	}
+	key := l.keyFn(l.filtered[l.selectedIdx])
+	if l.marked[key] {
+		delete(l.marked, key)
+	} else {
+		l.marked[key] = true
+	}
+}
+
+// MarkAllFiltered marks every item matching the current filter.
+// If they are all marked already, they are unmarked instead.
+func (l *FilteredList[T]) MarkAllFiltered() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.keyFn == nil {
+		return
+	}
+	all := true
+	for _, item := range l.filtered {
+		if !l.marked[l.keyFn(item)] {
+			all = false
+			break
+		}
+	}
+	for _, item := range l.filtered {
+		if all {
+			delete(l.marked, l.keyFn(item))
+		} else {
+			l.marked[l.keyFn(item)] = true
+		}
+	}
+}
+
+// ClearMarks unmarks all items and ends visual range selection.
+func (l *FilteredList[T]) ClearMarks() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.marked = make(map[any]bool)
+	l.visualAnchor = nil
+}
+
+// StartVisual starts visual range selection at the selected item.
+// Items between it and the selection are marked until EndVisual.
+func (l *FilteredList[T]) StartVisual() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.keyFn == nil || len(l.filtered) == 0 {
+		return
+	}
+	l.visualAnchor = l.keyFn(l.filtered[l.selectedIdx])
+}
+
+// EndVisual ends visual range selection, keeping the range marked.
+func (l *FilteredList[T]) EndVisual() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, item := range l.visualRange() {
+		l.marked[l.keyFn(item)] = true
+	}
+	l.visualAnchor = nil
+}
+
+// InVisual returns true if visual range selection is active.
+func (l *FilteredList[T]) InVisual() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.visualAnchor != nil
+}
+
+// visualRange returns the items between the visual anchor and the selection,
+// or only the selection if the anchor item is gone or filtered out.
+// Must be called with the lock held.
+func (l *FilteredList[T]) visualRange() []T {
+	if l.visualAnchor == nil || len(l.filtered) == 0 {
+		return nil
+	}
+	from, to := l.selectedIdx, l.selectedIdx
+	for i, item := range l.filtered {
+		if l.keyFn(item) == l.visualAnchor {
+			from = i
+			break
+		}
+	}
+	if from > to {
+		from, to = to, from
+	}
+	return l.filtered[from : to+1]
+}
+
+// IsMarked returns true if item is marked or inside the visual range.
+func (l *FilteredList[T]) IsMarked(item T) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.keyFn == nil {
+		return false
+	}
+	key := l.keyFn(item)
+	if l.marked[key] {
+		return true
+	}
+	for _, r := range l.visualRange() {
+		if l.keyFn(r) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Marked returns the marked items, including the visual range, in list order.
+// Marked items hidden by the filter are included.
+func (l *FilteredList[T]) Marked() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.keyFn == nil {
+		return nil
+	}
+	inRange := make(map[any]bool)
+	for _, item := range l.visualRange() {
+		inRange[l.keyFn(item)] = true
+	}
+	var marked []T
+	for _, item := range l.allItems {
+		key := l.keyFn(item)
+		if l.marked[key] || inRange[key] {
+			marked = append(marked, item)
+		}
+	}
+	return marked
+}
+
+// MarkedCount returns the number of marked items, including the visual range.
+func (l *FilteredList[T]) MarkedCount() int {
+	return len(l.Marked())
+}
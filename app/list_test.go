@@ -933,6 +933,158 @@ func TestFilteredList_SelectionPreservedOnRefilter(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Marking Tests
+// =============================================================================
+
+func newMarkableList(names ...string) *FilteredList[testItem] {
+	list := NewFilteredList(testMatchFn)
+	list.SetKeyFunc(func(item testItem) any { return item.ID })
+	items := make([]testItem, len(names))
+	for i, name := range names {
+		items[i] = testItem{Name: name, ID: i + 1}
+	}
+	list.SetItems(items)
+	return list
+}
+
+func markedIDs(list *FilteredList[testItem]) []int {
+	var ids []int
+	for _, item := range list.Marked() {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestFilteredList_ToggleMark(t *testing.T) {
+	list := newMarkableList("a", "b", "c")
+
+	list.ToggleMark()
+	list.SelectNext()
+	list.SelectNext()
+	list.ToggleMark()
+	if got := markedIDs(list); len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Errorf("Marked() = %v, want [1 3]", got)
+	}
+
+	list.ToggleMark()
+	if got := markedIDs(list); len(got) != 1 || got[0] != 1 {
+		t.Errorf("Marked() after unmark = %v, want [1]", got)
+	}
+}
+
+func TestFilteredList_MarkWithoutKeyFunc(t *testing.T) {
+	list := NewFilteredList(testMatchFn)
+	list.SetItems([]testItem{{Name: "a", ID: 1}})
+
+	list.ToggleMark()
+	list.MarkAllFiltered()
+	if list.MarkedCount() != 0 {
+		t.Errorf("MarkedCount() = %d, want 0 without a key function", list.MarkedCount())
+	}
+}
+
+func TestFilteredList_MarksSurviveSetItems(t *testing.T) {
+	list := newMarkableList("a", "b", "c")
+	list.ToggleMark()
+	list.SelectNext()
+	list.ToggleMark()
+
+	// Item 1 disappears, item 2 is refreshed in a new position
+	list.SetItems([]testItem{{Name: "new", ID: 9}, {Name: "b2", ID: 2}})
+	if got := markedIDs(list); len(got) != 1 || got[0] != 2 {
+		t.Errorf("Marked() = %v, want [2]", got)
+	}
+}
+
+func TestFilteredList_SelectionSurvivesSetItems(t *testing.T) {
+	list := newMarkableList("a", "b", "c")
+	list.SelectNext()
+
+	// A new item is inserted before the selected one
+	list.SetItems([]testItem{{Name: "new", ID: 9}, {Name: "a", ID: 1}, {Name: "b", ID: 2}, {Name: "c", ID: 3}})
+	if item, _ := list.Selected(); item.ID != 2 {
+		t.Errorf("Selected() = %d, want item 2 to stay selected", item.ID)
+	}
+
+	// The selected item is gone: the index is kept
+	list.SetItems([]testItem{{Name: "new", ID: 9}, {Name: "a", ID: 1}, {Name: "c", ID: 3}})
+	if item, _ := list.Selected(); item.ID != 3 {
+		t.Errorf("Selected() = %d, want the item now at the same index", item.ID)
+	}
+}
+
+func TestFilteredList_MarkAllFiltered(t *testing.T) {
+	list := newMarkableList("apple", "banana", "avocado")
+	list.SetFilter("a")
+	list.SetFilter("av")
+
+	list.MarkAllFiltered()
+	if got := markedIDs(list); len(got) != 1 || got[0] != 3 {
+		t.Errorf("Marked() = %v, want [3]", got)
+	}
+
+	// Marks hidden by the filter are kept
+	list.SetFilter("")
+	list.MarkAllFiltered()
+	if list.MarkedCount() != 3 {
+		t.Errorf("MarkedCount() = %d, want 3", list.MarkedCount())
+	}
+
+	// Marking again when all are marked unmarks them
+	list.MarkAllFiltered()
+	if list.MarkedCount() != 0 {
+		t.Errorf("MarkedCount() = %d, want 0", list.MarkedCount())
+	}
+}
+
+func TestFilteredList_VisualRange(t *testing.T) {
+	list := newMarkableList("a", "b", "c", "d")
+	list.SelectNext()
+
+	list.StartVisual()
+	if !list.InVisual() {
+		t.Fatal("InVisual() = false after StartVisual")
+	}
+	list.SelectNext()
+	list.SelectNext()
+	if got := markedIDs(list); len(got) != 3 || got[0] != 2 || got[2] != 4 {
+		t.Errorf("Marked() during visual = %v, want [2 3 4]", got)
+	}
+
+	// Moving back shrinks the range
+	list.SelectPrev()
+	if !list.IsMarked(testItem{ID: 3}) || list.IsMarked(testItem{ID: 4}) {
+		t.Errorf("visual range should be [2 3], got %v", markedIDs(list))
+	}
+
+	list.EndVisual()
+	if list.InVisual() {
+		t.Error("InVisual() = true after EndVisual")
+	}
+	if got := markedIDs(list); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("Marked() after visual = %v, want [2 3]", got)
+	}
+
+	list.ClearMarks()
+	if list.MarkedCount() != 0 {
+		t.Errorf("MarkedCount() after ClearMarks = %d, want 0", list.MarkedCount())
+	}
+}
+
+func TestFilteredList_VisualRangeSurvivesSetItems(t *testing.T) {
+	list := newMarkableList("a", "b", "c")
+	list.SelectNext()
+	list.StartVisual()
+	list.SelectNext()
+
+	// A poll inserts an item at the top: the range still covers b and c
+	list.SetItems([]testItem{{Name: "new", ID: 9}, {Name: "a", ID: 1}, {Name: "b", ID: 2}, {Name: "c", ID: 3}})
+	if got := markedIDs(list); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("Marked() after SetItems = %v, want [2 3]", got)
+	}
+}
+
 // =============================================================================
 // Benchmark Tests
 // =============================================================================
@@ -0,0 +1,530 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Log diff - the logs of a job compared with the logs of the job of the same
+// name in another run, step by step, ignoring run-specific noise
+
+const (
+	// DiffContext is the number of identical lines shown around changed lines
+	DiffContext = 3
+	// MaxDiffCells caps the size of the table used to align the lines of a step;
+	// larger steps are shown as entirely replaced
+	MaxDiffCells = 1 << 22
+)
+
+// diffKind is the kind of a line of a log diff
+type diffKind int
+
+const (
+	diffSame    diffKind = iota
+	diffRemoved          // Only in the base run
+	diffAdded            // Only in the compared run
+	diffStep             // Step header
+	diffGap              // Collapsed identical lines
+)
+
+// diffLine is a line of a log diff
+type diffLine struct {
+	kind  diffKind
+	text  string
+	first bool // First diverging line of its step
+}
+
+// diffOp pairs a line of the base logs with a line of the compared logs.
+// a or b is -1 when the line is only on one side.
+type diffOp struct {
+	kind diffKind
+	a, b int
+}
+
+// logSection is the lines of a step, up to the start of the next step
+type logSection struct {
+	name  string
+	lines []string
+}
+
+// logDiffView is the state of the log diff
+type logDiffView struct {
+	jobID   int64
+	title   string
+	loading bool
+	err     error
+	lines   []diffLine
+	firsts  []int // Indexes of the first diverging line of each step
+	offset  int
+}
+
+// Run-specific noise replaced before comparing lines
+var (
+	tempPathRegex = regexp.MustCompile(`(/home/runner/work/_temp|/private/var/folders|/var/folders|/tmp|[A-Za-z]:\\a\\_temp)[^\s'"]*`)
+	uuidRegex     = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	dateRegex     = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	clockRegex    = regexp.MustCompile(`\b\d{1,2}:\d{2}:\d{2}(\.\d+)?\b`)
+	durationRegex = regexp.MustCompile(`\b(\d+(\.\d+)?(ns|µs|us|ms|h|m|s))+\b|\b\d+(\.\d+)?\s?(secs?|seconds?|mins?|minutes?|hours?)\b`)
+	idRegex       = regexp.MustCompile(`(?i)\b[0-9a-f]{6,}\b`)
+	// Decimal IDs are only recognized after a name, as they cannot be told from counts
+	namedIDRegex = regexp.MustCompile(`(?i)\b((?:run|job|workflow)[ _-]?id[\s:=#]*)\d+\b`)
+)
+
+// stripTimestamp removes the timestamp GitHub prefixes log lines with
+func stripTimestamp(line string) string {
+	return timestampRegex.ReplaceAllString(line, "")
+}
+
+// normalizeLogLine replaces timestamps, temp paths, durations, and IDs
+// (named run and job IDs, commit SHAs, UUIDs) so that lines of different runs compare equal
+func normalizeLogLine(line string) string {
+	line = stripTimestamp(line)
+	line = tempPathRegex.ReplaceAllString(line, "<tmp>")
+	line = uuidRegex.ReplaceAllString(line, "<id>")
+	line = dateRegex.ReplaceAllString(line, "<date>")
+	line = clockRegex.ReplaceAllString(line, "<time>")
+	line = durationRegex.ReplaceAllString(line, "<duration>")
+	line = namedIDRegex.ReplaceAllString(line, "${1}<id>")
+	line = idRegex.ReplaceAllStringFunc(line, func(s string) string {
+		// Hex IDs mix digits and letters: plain numbers and words are left alone
+		if strings.ContainsAny(s, "0123456789") && strings.ContainsAny(s, "abcdefABCDEF") {
+			return "<id>"
+		}
+		return s
+	})
+	return strings.TrimRight(line, " \t\r")
+}
+
+// logSections splits logs at the step boundaries found by ParseLogs.
+// Lines after a step's group belong to it; lines before the first step form a "Set up" section.
+func logSections(p *ParsedLogs) []logSection {
+	lines := p.AllLines
+	if len(p.Steps) == 0 {
+		if len(lines) == 0 {
+			return nil
+		}
+		return []logSection{{name: "All logs", lines: lines}}
+	}
+	var sections []logSection
+	if start := p.Steps[0].StartLine; start > 0 {
+		sections = append(sections, logSection{name: "Set up", lines: lines[:start]})
+	}
+	for i, step := range p.Steps {
+		end := len(lines)
+		if i+1 < len(p.Steps) {
+			end = p.Steps[i+1].StartLine
+		}
+		sections = append(sections, logSection{name: step.Name, lines: lines[step.StartLine:end]})
+	}
+	return sections
+}
+
+// diffOps aligns n base items with m compared items on their longest common
+// subsequence. Removed items come before the added items that replace them.
+func diffOps(n, m int, equal func(a, b int) bool) []diffOp {
+	var ops []diffOp
+	pre := 0
+	for pre < n && pre < m && equal(pre, pre) {
+		ops = append(ops, diffOp{diffSame, pre, pre})
+		pre++
+	}
+	suf := 0
+	for suf < n-pre && suf < m-pre && equal(n-1-suf, m-1-suf) {
+		suf++
+	}
+
+	rows, cols := n-pre-suf, m-pre-suf
+	if rows*cols > MaxDiffCells {
+		for i := range rows {
+			ops = append(ops, diffOp{diffRemoved, pre + i, -1})
+		}
+		for j := range cols {
+			ops = append(ops, diffOp{diffAdded, -1, pre + j})
+		}
+	} else {
+		// lcs[i*w+j] is the length of the common subsequence of the items from i and j on
+		w := cols + 1
+		lcs := make([]int32, (rows+1)*w)
+		for i := rows - 1; i >= 0; i-- {
+			for j := cols - 1; j >= 0; j-- {
+				if equal(pre+i, pre+j) {
+					lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+				} else {
+					lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < rows || j < cols {
+			switch {
+			case i < rows && j < cols && equal(pre+i, pre+j):
+				ops = append(ops, diffOp{diffSame, pre + i, pre + j})
+				i++
+				j++
+			case j == cols || (i < rows && lcs[(i+1)*w+j] >= lcs[i*w+j+1]):
+				ops = append(ops, diffOp{diffRemoved, pre + i, -1})
+				i++
+			default:
+				ops = append(ops, diffOp{diffAdded, -1, pre + j})
+				j++
+			}
+		}
+	}
+
+	for k := range suf {
+		ops = append(ops, diffOp{diffSame, n - suf + k, m - suf + k})
+	}
+	return ops
+}
+
+// diffLogs compares the logs of a job in a base run with its logs in another run.
+// Steps are aligned by name, then their lines are compared once normalized.
+func diffLogs(base, logs string) []diffLine {
+	baseSections := logSections(ParseLogs(base))
+	sections := logSections(ParseLogs(logs))
+	stepOps := diffOps(len(baseSections), len(sections), func(a, b int) bool {
+		return normalizeLogLine(baseSections[a].name) == normalizeLogLine(sections[b].name)
+	})
+
+	var out []diffLine
+	for _, op := range stepOps {
+		switch op.kind {
+		case diffRemoved:
+			body, _ := diffLines(baseSections[op.a].lines, nil, normalizeLogLine, stripTimestamp)
+			out = append(out, diffLine{kind: diffStep, text: baseSections[op.a].name + "  (only in base run)"})
+			out = append(out, body...)
+		case diffAdded:
+			body, _ := diffLines(nil, sections[op.b].lines, normalizeLogLine, stripTimestamp)
+			out = append(out, diffLine{kind: diffStep, text: sections[op.b].name + "  (only in this run)"})
+			out = append(out, body...)
+		default:
+			body, changed := diffLines(baseSections[op.a].lines, sections[op.b].lines, normalizeLogLine, stripTimestamp)
+			if !changed {
+				out = append(out, diffLine{kind: diffStep, text: sections[op.b].name + "  (identical)"})
+				continue
+			}
+			out = append(out, diffLine{kind: diffStep, text: sections[op.b].name})
+			out = append(out, body...)
+		}
+	}
+	return out
+}
+
+// diffLines compares lines once normalized, keeping DiffContext identical lines
+// around changed lines, and reports whether any line changed.
+// Lines are shown as returned by show.
+func diffLines(base, lines []string, normalize, show func(string) string) ([]diffLine, bool) {
+	baseNorm := make([]string, len(base))
+	for i, line := range base {
+		baseNorm[i] = normalize(line)
+	}
+	norm := make([]string, len(lines))
+	for i, line := range lines {
+		norm[i] = normalize(line)
+	}
+	ops := diffOps(len(base), len(lines), func(a, b int) bool { return baseNorm[a] == norm[b] })
+
+	// Identical lines are kept only near a change
+	keep := make([]bool, len(ops))
+	changed := false
+	for i, op := range ops {
+		if op.kind == diffSame {
+			continue
+		}
+		changed = true
+		for k := max(i-DiffContext, 0); k <= min(i+DiffContext, len(ops)-1); k++ {
+			keep[k] = true
+		}
+	}
+	if !changed {
+		return nil, false
+	}
+	// A single hidden line takes as much room as the gap replacing it
+	for i := range keep {
+		if !keep[i] && (i == 0 || keep[i-1]) && (i == len(keep)-1 || keep[i+1]) {
+			keep[i] = true
+		}
+	}
+
+	var out []diffLine
+	first := true
+	hidden := 0
+	for i, op := range ops {
+		if !keep[i] {
+			hidden++
+			continue
+		}
+		if hidden > 0 {
+			out = append(out, diffLine{kind: diffGap, text: gapText(hidden)})
+			hidden = 0
+		}
+		line := diffLine{kind: op.kind}
+		switch op.kind {
+		case diffRemoved:
+			line.text = show(base[op.a])
+		default:
+			line.text = show(lines[op.b])
+		}
+		if op.kind != diffSame && first {
+			line.first = true
+			first = false
+		}
+		out = append(out, line)
+	}
+	if hidden > 0 {
+		out = append(out, diffLine{kind: diffGap, text: gapText(hidden)})
+	}
+	return out, true
+}
+
+// gapText describes n collapsed identical lines
+func gapText(n int) string {
+	return "⋯ " + strconv.Itoa(n) + " identical lines"
+}
+
+// firstDivergences returns the indexes of the first diverging line of each step
+func firstDivergences(lines []diffLine) []int {
+	var firsts []int
+	for i, line := range lines {
+		if line.first {
+			firsts = append(firsts, i)
+		}
+	}
+	return firsts
+}
+
+// fetchLogDiff creates a command to fetch the logs of job and of the job of
+// the same name in the base run, and to compare them.
+// It captures the client, repo, base run, and job to avoid race conditions.
+func fetchLogDiff(ctx context.Context, client github.Client, repo github.Repository, base github.Run, job github.Job) tea.Cmd {
+	return func() tea.Msg {
+		baseJobs, err := client.ListJobs(ctx, repo, base.ID)
+		if err != nil {
+			return LogDiffLoadedMsg{JobID: job.ID, Err: err}
+		}
+		var baseJob *github.Job
+		for i := range baseJobs {
+			if baseJobs[i].Name == job.Name {
+				baseJob = &baseJobs[i]
+				break
+			}
+		}
+		if baseJob == nil {
+			return LogDiffLoadedMsg{JobID: job.ID, Err: fmt.Errorf("no job named %q in run #%d", job.Name, base.RunNumber)}
+		}
+		baseLogs, err := client.GetJobLogs(ctx, repo, baseJob.ID)
+		if err != nil {
+			return LogDiffLoadedMsg{JobID: job.ID, Err: err}
+		}
+		logs, err := client.GetJobLogs(ctx, repo, job.ID)
+		if err != nil {
+			return LogDiffLoadedMsg{JobID: job.ID, Err: err}
+		}
+		return LogDiffLoadedMsg{
+			JobID: job.ID,
+			Lines: diffLogs(github.SanitizeLogs(baseLogs), github.SanitizeLogs(logs)),
+		}
+	}
+}
+
+// toggleCompareBase marks the selected run as the base run of log diffs,
+// or clears the mark if it is already the base
+func (a *App) toggleCompareBase() tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok {
+		return nil
+	}
+	if a.compareBase != nil && a.compareBase.ID == run.ID {
+		a.compareBase = nil
+		return flashMessage("Compare base cleared", FlashDurationInfo)
+	}
+	a.compareBase = &run
+	return flashMessage("Compare base: #"+strconv.Itoa(run.RunNumber)+" - select a run and a job, then press =", FlashDurationInfo)
+}
+
+// lastSuccessfulRunBefore returns the newest successful run created before run
+func (a *App) lastSuccessfulRunBefore(run github.Run) (github.Run, bool) {
+	var found github.Run
+	ok := false
+	for _, r := range a.runs.Items() {
+		if r.ID == run.ID || r.Conclusion != "success" || !r.CreatedAt.Before(run.CreatedAt) {
+			continue
+		}
+		if !ok || r.CreatedAt.After(found.CreatedAt) {
+			found, ok = r, true
+		}
+	}
+	return found, ok
+}
+
+// compareJobLogs diffs the logs of the selected job against the job of the same
+// name in the compare base, or in the last successful run if no base is marked
+func (a *App) compareJobLogs() tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	run, ok := a.runs.Selected()
+	if !ok {
+		return nil
+	}
+	job, ok := a.jobs.Selected()
+	if !ok {
+		return nil
+	}
+
+	var base github.Run
+	switch {
+	case a.compareBase != nil:
+		base = *a.compareBase
+	default:
+		if base, ok = a.lastSuccessfulRunBefore(run); !ok {
+			return flashMessage("Mark a run to compare with [=] in the runs pane", FlashDurationInfo)
+		}
+	}
+	if base.ID == run.ID {
+		return flashMessage("Select another run than the compare base", FlashDurationInfo)
+	}
+
+	a.logDiff = &logDiffView{
+		jobID:   job.ID,
+		title:   fmt.Sprintf("Log diff - %s  #%d → #%d", job.Name, base.RunNumber, run.RunNumber),
+		loading: true,
+	}
+	ctx := newRequestContext(&a.cancelLogDiff)
+	return fetchLogDiff(ctx, a.client, a.repo, base, job)
+}
+
+// handleLogDiffLoaded shows a loaded log diff, scrolled to its first divergence
+func (a *App) handleLogDiffLoaded(msg LogDiffLoadedMsg) {
+	d := a.logDiff
+	if d == nil || d.jobID != msg.JobID || isCancelled(msg.Err) {
+		return
+	}
+	d.loading = false
+	if msg.Err != nil {
+		d.err = msg.Err
+		return
+	}
+	d.lines = msg.Lines
+	d.firsts = firstDivergences(msg.Lines)
+	if len(d.firsts) > 0 {
+		d.offset = divergenceOffset(d.firsts[0])
+	}
+}
+
+// divergenceOffset returns the scroll offset showing the diverging line at i with its context
+func divergenceOffset(i int) int {
+	return max(i-DiffContext-1, 0)
+}
+
+// logDiffHeight returns the number of diff lines shown at once
+func (a *App) logDiffHeight() int {
+	// Title, summary, and key hints
+	return max(a.height-StatusBarHeight-3, 1)
+}
+
+// handleLogDiffInput handles input when the log diff is shown
+func (a *App) handleLogDiffInput(msg tea.KeyMsg) tea.Cmd {
+	d := a.logDiff
+	maxOffset := max(len(d.lines)-a.logDiffHeight(), 0)
+
+	switch msg.String() {
+	case "up", "k":
+		d.offset = max(d.offset-1, 0)
+	case "down", "j":
+		d.offset = min(d.offset+1, maxOffset)
+	case "pgup":
+		d.offset = max(d.offset-a.logDiffHeight(), 0)
+	case "pgdown", " ":
+		d.offset = min(d.offset+a.logDiffHeight(), maxOffset)
+	case "g":
+		d.offset = 0
+	case "G":
+		d.offset = maxOffset
+	case "n":
+		for _, i := range d.firsts {
+			if off := divergenceOffset(i); off > d.offset {
+				d.offset = min(off, maxOffset)
+				break
+			}
+		}
+	case "N":
+		for k := len(d.firsts) - 1; k >= 0; k-- {
+			if off := divergenceOffset(d.firsts[k]); off < d.offset {
+				d.offset = off
+				break
+			}
+		}
+	case "esc", "q", "=":
+		cancelRequest(&a.cancelLogDiff)
+		a.logDiff = nil
+	}
+	return nil
+}
+
+// renderDiffLine renders a line of a log diff, truncated to width
+func renderDiffLine(line diffLine, width int) string {
+	switch line.kind {
+	case diffStep:
+		return DiffStepStyle.Render(truncateString("▸ "+line.text, width))
+	case diffGap:
+		return QueuedStyle.Render(truncateString("  "+line.text, width))
+	case diffSame:
+		return truncateString("  "+line.text, width)
+	}
+
+	prefix := "+ "
+	style := DiffAddedStyle
+	if line.kind == diffRemoved {
+		prefix = "- "
+		style = DiffRemovedStyle
+	}
+	if line.first {
+		style = DiffFirstStyle
+	}
+	return style.Render(truncateString(prefix+line.text, width))
+}
+
+// renderLogDiff renders the log diff full-screen
+func (a *App) renderLogDiff() string {
+	d := a.logDiff
+	width := a.width - 4
+
+	lines := []string{FocusedTitle.Render(d.title)}
+	switch {
+	case d.loading:
+		lines = append(lines, "Loading logs...")
+	case d.err != nil:
+		lines = append(lines, "Unavailable: "+d.err.Error())
+	case len(d.firsts) == 0:
+		lines = append(lines, "No differences once timestamps, IDs, temp paths, and durations are ignored")
+	default:
+		steps := 0
+		for _, line := range d.lines {
+			if line.kind == diffStep {
+				steps++
+			}
+		}
+		lines = append(lines, QueuedStyle.Render(fmt.Sprintf("%d of %d steps differ  (- base run, + this run)", len(d.firsts), steps)))
+		end := min(d.offset+a.logDiffHeight(), len(d.lines))
+		for _, line := range d.lines[d.offset:end] {
+			lines = append(lines, renderDiffLine(line, width))
+		}
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	hints := QueuedStyle.Render("[j/k] scroll  [n/N] next/previous divergence  [g/G] top/bottom  [esc] close")
+
+	return FocusedPane.
+		Width(a.width).
+		Height(a.height - StatusBarHeight).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.PlaceVertical(a.height-StatusBarHeight-1, lipgloss.Top, content),
+			hints))
+}
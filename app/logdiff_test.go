@@ -0,0 +1,255 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func TestNormalizeLogLine(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"2024-01-15T10:30:00.1234567Z Running tests", "2024-01-16T08:00:12.7654321Z Running tests"},
+		{"ok  pkg/app  1.234s", "ok  pkg/app  0.98s"},
+		{"Done in 1m30.5s", "Done in 45s"},
+		{"took 3 seconds", "took 12 seconds"},
+		{"Run ID 7301234567", "Run ID 7309876543"},
+		{"job_id=41234567890", "job_id=41298765432"},
+		{"HEAD is now at 3f2a9c1 Fix build", "HEAD is now at 9bd0e4a Fix build"},
+		{"/home/runner/work/_temp/abc-123.sh: line 1", "/home/runner/work/_temp/def-456.sh: line 1"},
+		{"wrote /tmp/go-build1234/b001/exe", "wrote /tmp/go-build9876/b001/exe"},
+		{"started at 10:30:00", "started at 11:02:59.123"},
+		{"id 123e4567-e89b-12d3-a456-426614174000", "id 00000000-1111-2222-3333-444444444444"},
+	}
+	for _, tt := range tests {
+		if a, b := normalizeLogLine(tt.a), normalizeLogLine(tt.b); a != b {
+			t.Errorf("normalizeLogLine(%q) = %q, normalizeLogLine(%q) = %q, want equal", tt.a, a, tt.b, b)
+		}
+	}
+
+	for _, tt := range []struct{ a, b string }{
+		{"exit code 1", "exit code 2"},
+		{"FAIL TestFoo", "ok TestFoo"},
+		{"decade facade", "decade façade"},
+		{"Ran 123456 tests", "Ran 123457 tests"},
+		{"port 8080808", "port 8080809"},
+	} {
+		if normalizeLogLine(tt.a) == normalizeLogLine(tt.b) {
+			t.Errorf("normalizeLogLine(%q) should differ from normalizeLogLine(%q)", tt.a, tt.b)
+		}
+	}
+}
+
+func TestDiffOps(t *testing.T) {
+	a := []string{"a", "b", "c", "d"}
+	b := []string{"a", "x", "c", "d", "e"}
+	ops := diffOps(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+
+	var got []string
+	for _, op := range ops {
+		switch op.kind {
+		case diffSame:
+			got = append(got, " "+a[op.a])
+		case diffRemoved:
+			got = append(got, "-"+a[op.a])
+		case diffAdded:
+			got = append(got, "+"+b[op.b])
+		}
+	}
+	want := " a,-b,+x, c, d,+e"
+	if strings.Join(got, ",") != want {
+		t.Errorf("diffOps() = %q, want %q", strings.Join(got, ","), want)
+	}
+}
+
+func TestDiffLogs(t *testing.T) {
+	base := strings.Join([]string{
+		"2024-01-15T10:00:00.0000000Z ##[group]Set up job",
+		"2024-01-15T10:00:00.0000000Z Runner 2.311.0",
+		"2024-01-15T10:00:00.0000000Z ##[endgroup]",
+		"2024-01-15T10:00:01.0000000Z ##[group]Run go test ./...",
+		"2024-01-15T10:00:01.0000000Z go test ./...",
+		"2024-01-15T10:00:01.0000000Z ##[endgroup]",
+		"2024-01-15T10:00:05.0000000Z ok  pkg/a  1.2s",
+		"2024-01-15T10:00:05.0000000Z ok  pkg/b  0.3s",
+		"2024-01-15T10:00:05.0000000Z ok  pkg/c  0.5s",
+	}, "\n")
+	logs := strings.Join([]string{
+		"2024-01-16T09:00:00.0000000Z ##[group]Set up job",
+		"2024-01-16T09:00:00.0000000Z Runner 2.311.0",
+		"2024-01-16T09:00:00.0000000Z ##[endgroup]",
+		"2024-01-16T09:00:01.0000000Z ##[group]Run go test ./...",
+		"2024-01-16T09:00:01.0000000Z go test ./...",
+		"2024-01-16T09:00:01.0000000Z ##[endgroup]",
+		"2024-01-16T09:00:07.0000000Z ok  pkg/a  2.8s",
+		"2024-01-16T09:00:07.0000000Z --- FAIL: TestB (0.01s)",
+		"2024-01-16T09:00:07.0000000Z FAIL  pkg/b  0.4s",
+		"2024-01-16T09:00:07.0000000Z ok  pkg/c  0.5s",
+		"2024-01-16T09:00:08.0000000Z ##[group]Upload coverage",
+		"2024-01-16T09:00:08.0000000Z uploading",
+	}, "\n")
+
+	lines := diffLogs(base, logs)
+
+	var got []string
+	for _, line := range lines {
+		prefix := map[diffKind]string{diffSame: " ", diffRemoved: "-", diffAdded: "+", diffStep: "#", diffGap: "~"}[line.kind]
+		if line.first {
+			prefix = "!" + prefix
+		}
+		got = append(got, prefix+line.text)
+	}
+	want := []string{
+		"#Set up job  (identical)",
+		"#Run go test ./...",
+		" ##[group]Run go test ./...",
+		" go test ./...",
+		" ##[endgroup]",
+		" ok  pkg/a  2.8s",
+		"!-ok  pkg/b  0.3s",
+		"+--- FAIL: TestB (0.01s)",
+		"+FAIL  pkg/b  0.4s",
+		" ok  pkg/c  0.5s",
+		"#Upload coverage  (only in this run)",
+		"!+##[group]Upload coverage",
+		"+uploading",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diffLogs() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if firsts := firstDivergences(lines); len(firsts) != 2 || firsts[0] != 6 {
+		t.Errorf("firstDivergences() = %v, want [6 11]", firsts)
+	}
+}
+
+func TestDiffLines_CollapsesIdenticalLines(t *testing.T) {
+	var base []string
+	for i := range 20 {
+		base = append(base, "line "+string(rune('a'+i)))
+	}
+	lines := append([]string{}, base...)
+	lines[10] = "changed"
+
+	got, changed := diffLines(base, lines, normalizeLogLine, stripTimestamp)
+	if !changed {
+		t.Fatal("diffLines() should report a change")
+	}
+	if got[0].kind != diffGap || got[0].text != "⋯ 7 identical lines" {
+		t.Errorf("got[0] = %+v, want 7 collapsed lines", got[0])
+	}
+	if last := got[len(got)-1]; last.kind != diffGap || last.text != "⋯ 6 identical lines" {
+		t.Errorf("last = %+v, want 6 collapsed lines", last)
+	}
+	if len(got) != 2+2*DiffContext+2 {
+		t.Errorf("diffLines() returned %d lines, want context around one changed line", len(got))
+	}
+}
+
+func TestApp_CompareJobLogs(t *testing.T) {
+	now := time.Now()
+	runs := []github.Run{
+		{ID: 2, RunNumber: 12, Status: "completed", Conclusion: "failure", CreatedAt: now},
+		{ID: 1, RunNumber: 11, Status: "completed", Conclusion: "success", CreatedAt: now.Add(-time.Hour)},
+	}
+	mock := newMockClient(&mockClientState{runs: runs})
+	mock.ListJobsFunc = func(_ context.Context, _ github.Repository, runID int64) ([]github.Job, error) {
+		return []github.Job{{ID: runID * 100, Name: "test"}}, nil
+	}
+	mock.GetJobLogsFunc = func(_ context.Context, _ github.Repository, jobID int64) (string, error) {
+		if jobID == 100 {
+			return "##[group]Run tests\nok  pkg/a  1.2s\n", nil
+		}
+		return "##[group]Run tests\nFAIL  pkg/a  1.4s\n", nil
+	}
+	app := New(WithClient(mock))
+	app.width = 120
+	app.height = 40
+	app.runs.SetItems(runs)
+	app.jobs.SetItems([]github.Job{{ID: 200, Name: "test", Status: "completed", Conclusion: "failure"}})
+
+	// Without a marked base, the last successful run is compared
+	app.focusedPane = JobsPane
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	if app.logDiff == nil || !app.logDiff.loading {
+		t.Fatal("log diff should open while loading")
+	}
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+	if calls := mock.ListJobsCalls(); len(calls) != 1 || calls[0].RunID != 1 {
+		t.Errorf("ListJobs calls = %v, want the jobs of run 1", calls)
+	}
+
+	view := app.View()
+	for _, want := range []string{"test  #11 → #12", "1 of 1 steps differ", "- ok  pkg/a  1.2s", "+ FAIL  pkg/a  1.4s"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("log diff should contain %q:\n%s", want, view)
+		}
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.logDiff != nil {
+		t.Fatal("Esc should close the log diff")
+	}
+
+	// Marking the selected run as base, then comparing it with itself
+	app.focusedPane = RunsPane
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	if app.compareBase == nil || app.compareBase.ID != 2 {
+		t.Fatalf("compareBase = %v, want run 2", app.compareBase)
+	}
+	if !strings.Contains(app.View(), "=") {
+		t.Error("runs pane should mark the compare base")
+	}
+	app.focusedPane = JobsPane
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	if app.logDiff != nil {
+		t.Error("a run should not be compared with itself")
+	}
+
+	app.focusedPane = RunsPane
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	if app.compareBase != nil {
+		t.Error("= on the base run should clear it")
+	}
+}
+
+func TestApp_LogDiffIgnoresStaleResults(t *testing.T) {
+	app := New()
+	app.logDiff = &logDiffView{jobID: 2, loading: true}
+
+	app.Update(LogDiffLoadedMsg{JobID: 1, Lines: []diffLine{{kind: diffAdded, text: "x", first: true}}})
+
+	if !app.logDiff.loading || len(app.logDiff.lines) != 0 {
+		t.Errorf("log diff = %+v, results for another job should be ignored", app.logDiff)
+	}
+}
+
+func TestApp_LogDiffCancelledOnClose(t *testing.T) {
+	now := time.Now()
+	runs := []github.Run{
+		{ID: 2, RunNumber: 12, Status: "completed", Conclusion: "failure", CreatedAt: now},
+		{ID: 1, RunNumber: 11, Status: "completed", Conclusion: "success", CreatedAt: now.Add(-time.Hour)},
+	}
+	mock := newMockClient(&mockClientState{runs: runs})
+	mock.ListJobsFunc = func(ctx context.Context, _ github.Repository, _ int64) ([]github.Job, error) {
+		return nil, ctx.Err()
+	}
+	app := New(WithClient(mock))
+	app.runs.SetItems(runs)
+	app.jobs.SetItems([]github.Job{{ID: 200, Name: "test", Status: "completed", Conclusion: "failure"}})
+	app.focusedPane = JobsPane
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'='}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+
+	msg := cmd().(LogDiffLoadedMsg)
+	if !isCancelled(msg.Err) {
+		t.Errorf("err = %v, want the request cancelled once the log diff is closed", msg.Err)
+	}
+}
@@ -0,0 +1,260 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Logs outside the TUI: the shown logs (the selected step, or the whole job)
+// are opened in $PAGER or $EDITOR from a temp file while the TUI is suspended,
+// or saved to a file. Logs are sanitized when they are fetched.
+
+// logViewer is a program logs are opened in
+type logViewer int
+
+const (
+	pagerViewer logViewer = iota
+	editorViewer
+)
+
+// Default programs when the environment sets none
+const (
+	defaultPager  = "less"
+	defaultEditor = "vi"
+)
+
+// ansiRegex matches ANSI escape sequences (CSI and OSC)
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
+
+// fileNameRegex matches runs of characters left out of log file names
+var fileNameRegex = regexp.MustCompile(`[^A-Za-z0-9._]+`)
+
+// stripANSI removes ANSI escape sequences (colors, cursor movement) from s
+func stripANSI(s string) string {
+	return ansiRegex.ReplaceAllString(s, "")
+}
+
+// viewerCommand returns the command opening path: $PAGER for the pager,
+// $VISUAL or $EDITOR for the editor, with arguments if they have any
+func viewerCommand(viewer logViewer, path string, getenv func(string) string) (string, []string) {
+	program := defaultPager
+	if viewer == editorViewer {
+		program = defaultEditor
+		if v := getenv("EDITOR"); strings.TrimSpace(v) != "" {
+			program = v
+		}
+		if v := getenv("VISUAL"); strings.TrimSpace(v) != "" {
+			program = v
+		}
+	} else if v := getenv("PAGER"); strings.TrimSpace(v) != "" {
+		program = v
+	}
+	fields := strings.Fields(program)
+	return fields[0], append(fields[1:], path)
+}
+
+// logFileName returns the file name of the logs of a job, or of one of its steps
+func logFileName(runNumber int, job, step string) string {
+	parts := []string{"run", strconv.Itoa(runNumber), job}
+	if step != "" {
+		parts = append(parts, step)
+	}
+	name := fileNameRegex.ReplaceAllString(strings.Join(parts, "-"), "-")
+	return strings.Trim(name, "-.") + ".log"
+}
+
+// shownLogs returns the logs shown for the selected job, the selected step's
+// if one is selected, and the file name they are written to
+func (a *App) shownLogs() (logs, name string, ok bool) {
+	job, selected := a.jobs.Selected()
+	if !selected || a.parsedLogs == nil {
+		return "", "", false
+	}
+	logs = a.parsedLogs.GetStepLogs(a.selectedStepIdx)
+	if logs == "" {
+		return "", "", false
+	}
+
+	var step string
+	if a.selectedStepIdx >= 0 && a.selectedStepIdx < len(a.parsedLogs.Steps) {
+		step = a.parsedLogs.Steps[a.selectedStepIdx].Name
+	}
+	var runNumber int
+	if run, ok := a.runs.Selected(); ok {
+		runNumber = run.RunNumber
+	}
+	return logs, logFileName(runNumber, job.Name, step), true
+}
+
+// openLogs writes the shown logs to a temp file and suspends the TUI to open
+// it in the pager or editor. Colors are kept for the pager only; less shows
+// them when $LESS is unset.
+func (a *App) openLogs(viewer logViewer) tea.Cmd {
+	logs, name, ok := a.shownLogs()
+	if !ok {
+		return flashMessage("No logs loaded", FlashDurationInfo)
+	}
+	if viewer == editorViewer {
+		logs = stripANSI(logs)
+	}
+
+	path, err := writeTempLog(name, logs)
+	if err != nil {
+		return flashMessage("Could not write logs: "+err.Error(), FlashDurationInfo)
+	}
+
+	program, args := viewerCommand(viewer, path, os.Getenv)
+	cmd := exec.Command(program, args...)
+	if viewer == pagerViewer && os.Getenv("LESS") == "" {
+		cmd.Env = append(os.Environ(), "LESS=R")
+	}
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return LogViewerClosedMsg{Path: path, Err: err}
+	})
+}
+
+// writeTempLog writes logs to a new temp file named after name
+func writeTempLog(name, logs string) (string, error) {
+	f, err := os.CreateTemp("", "lazyactions-*-"+name)
+	if err != nil {
+		return "", err
+	}
+	_, err = f.WriteString(logs)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// handleLogViewerClosed removes the temp file once the pager or editor exits
+func (a *App) handleLogViewerClosed(msg LogViewerClosedMsg) tea.Cmd {
+	_ = os.Remove(msg.Path)
+	if msg.Err != nil {
+		return flashMessage("Could not open logs: "+msg.Err.Error(), FlashDurationInfo)
+	}
+	return nil
+}
+
+// saveLogsDialog is the state of the dialog saving the shown logs to a file
+type saveLogsDialog struct {
+	logs       string
+	input      textinput.Model
+	keepColors bool
+	overwrite  string // Path confirmed to be overwritten
+}
+
+// confirmSaveLogs opens the dialog saving the shown logs, to a file in the
+// working directory by default
+func (a *App) confirmSaveLogs() tea.Cmd {
+	logs, name, ok := a.shownLogs()
+	if !ok {
+		return flashMessage("No logs loaded", FlashDurationInfo)
+	}
+
+	ti := textinput.New()
+	ti.SetValue(name)
+	ti.CharLimit = 4096
+	ti.Focus()
+
+	a.savingLogs = &saveLogsDialog{logs: logs, input: ti}
+	return textinput.Blink
+}
+
+// handleSaveLogsInput handles input when in the save dialog
+func (a *App) handleSaveLogsInput(msg tea.KeyMsg) tea.Cmd {
+	d := a.savingLogs
+
+	switch msg.String() {
+	case "esc":
+		a.savingLogs = nil
+		return nil
+	case "tab":
+		d.keepColors = !d.keepColors
+		return nil
+	case "enter":
+		path, err := expandHome(strings.TrimSpace(d.input.Value()))
+		if err != nil || path == "" {
+			return flashMessage("Enter a file path", FlashDurationInfo)
+		}
+		if _, err := os.Stat(path); err == nil && d.overwrite != path {
+			d.overwrite = path
+			return flashMessage(path+" exists; press Enter again to overwrite", FlashDurationInfo)
+		}
+		logs := d.logs
+		if !d.keepColors {
+			logs = stripANSI(logs)
+		}
+		a.savingLogs = nil
+		return saveLogs(path, logs)
+	}
+
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return cmd
+}
+
+// expandHome replaces a leading ~ in path with the home directory
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
+
+// saveLogs creates a command to write logs to path.
+// It captures the path and logs to avoid race conditions.
+func saveLogs(path, logs string) tea.Cmd {
+	return func() tea.Msg {
+		err := os.WriteFile(path, []byte(logs), 0o644)
+		return LogsSavedMsg{Path: path, Err: err}
+	}
+}
+
+// handleLogsSaved reports where the logs were saved
+func (a *App) handleLogsSaved(msg LogsSavedMsg) tea.Cmd {
+	if msg.Err != nil {
+		return flashMessage("Could not save logs: "+msg.Err.Error(), FlashDurationInfo)
+	}
+	return flashMessage("Saved logs to "+msg.Path, FlashDurationSuccess)
+}
+
+// renderSaveLogsDialog renders the save dialog
+func (a *App) renderSaveLogsDialog() string {
+	d := a.savingLogs
+
+	colors := "[ ]"
+	if d.keepColors {
+		colors = "[x]"
+	}
+	lines := []string{
+		lipgloss.NewStyle().Bold(true).Render("Save logs"),
+		"",
+		"Path:",
+		d.input.View(),
+		"",
+		fmt.Sprintf("%s Keep ANSI colors", colors),
+		"",
+		"[Enter] Save  [Tab] Toggle colors  [Esc] Cancel",
+	}
+
+	dialog := ConfirmDialog.Width(60).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height,
+		lipgloss.Center, lipgloss.Center, dialog)
+}
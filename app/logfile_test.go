@@ -0,0 +1,167 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+const coloredLogs = "##[group]Run make test\n\x1b[32mok\x1b[0m  pkg\n##[endgroup]\n"
+
+func newLogFileTestApp() *App {
+	app := New(WithRepository(github.Repository{Owner: "owner", Name: "repo"}))
+	app.runs.SetItems([]github.Run{{ID: 10, RunNumber: 7}})
+	app.jobs.SetItems([]github.Job{{ID: 100, Name: "test (ubuntu, 1.22)"}})
+	app.focusedPane = JobsPane
+	app.parsedLogs = ParseLogs(coloredLogs)
+	return app
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"\x1b[32mok\x1b[0m", "ok"},
+		{"\x1b[1;31mFAIL\x1b[m done", "FAIL done"},
+		{"\x1b]8;;https://x\x07link\x1b]8;;\x07", "link"},
+		{"plain", "plain"},
+	}
+	for _, tt := range tests {
+		if got := stripANSI(tt.in); got != tt.want {
+			t.Errorf("stripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestViewerCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		viewer   logViewer
+		env      map[string]string
+		wantName string
+		wantArgs string
+	}{
+		{"default pager", pagerViewer, nil, "less", "/tmp/x.log"},
+		{"$PAGER", pagerViewer, map[string]string{"PAGER": "bat --paging=always"}, "bat", "--paging=always /tmp/x.log"},
+		{"default editor", editorViewer, nil, "vi", "/tmp/x.log"},
+		{"$EDITOR", editorViewer, map[string]string{"EDITOR": "nvim"}, "nvim", "/tmp/x.log"},
+		{"$VISUAL first", editorViewer, map[string]string{"EDITOR": "nano", "VISUAL": "code -w"}, "code", "-w /tmp/x.log"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, args := viewerCommand(tt.viewer, "/tmp/x.log", func(k string) string { return tt.env[k] })
+			if name != tt.wantName || strings.Join(args, " ") != tt.wantArgs {
+				t.Errorf("viewerCommand() = %s %v, want %s %s", name, args, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLogFileName(t *testing.T) {
+	if got := logFileName(7, "test (ubuntu, 1.22)", ""); got != "run-7-test-ubuntu-1.22.log" {
+		t.Errorf("logFileName() = %q", got)
+	}
+	if got := logFileName(7, "build", "Run ./scripts/build.sh"); got != "run-7-build-Run-.-scripts-build.sh.log" {
+		t.Errorf("logFileName() = %q", got)
+	}
+}
+
+func TestApp_OpenLogs(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	app := newLogFileTestApp()
+
+	if cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}}); cmd == nil {
+		t.Fatal("E should open the logs in the editor")
+	}
+	files, _ := filepath.Glob(filepath.Join(os.TempDir(), "lazyactions-*-run-7-test-ubuntu-1.22.log"))
+	if len(files) != 1 {
+		t.Fatalf("temp files = %v, want one", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if string(data) != stripANSI(coloredLogs) {
+		t.Errorf("temp file = %q, want the logs without colors", data)
+	}
+
+	// The temp file is removed once the editor exits
+	app.Update(LogViewerClosedMsg{Path: files[0]})
+	if _, err := os.Stat(files[0]); !os.IsNotExist(err) {
+		t.Errorf("temp file should be removed, got %v", err)
+	}
+}
+
+func TestApp_OpenLogs_NoLogs(t *testing.T) {
+	app := newLogFileTestApp()
+	app.parsedLogs = nil
+
+	msgs := runCmds(app.openLogs(pagerViewer))
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "No logs loaded" {
+		t.Errorf("msgs = %v, want a flash message", msgs)
+	}
+}
+
+func TestApp_SaveLogs(t *testing.T) {
+	dir := t.TempDir()
+	app := newLogFileTestApp()
+	app.selectedStepIdx = 0
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if app.savingLogs == nil {
+		t.Fatal("s should open the save dialog")
+	}
+	if got := app.savingLogs.input.Value(); got != "run-7-test-ubuntu-1.22-Run-make-test.log" {
+		t.Errorf("default path = %q", got)
+	}
+	app.width, app.height = 120, 40
+	if view := app.View(); !strings.Contains(view, "[ ] Keep ANSI colors") {
+		t.Errorf("dialog should offer to keep colors:\n%s", view)
+	}
+
+	path := filepath.Join(dir, "step.log")
+	app.savingLogs.input.SetValue(path)
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	for _, msg := range runCmds(app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})) {
+		_, cmd := app.Update(msg)
+		for _, msg := range runCmds(cmd) {
+			app.Update(msg)
+		}
+	}
+	if app.savingLogs != nil {
+		t.Error("dialog should close after saving")
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != strings.TrimSuffix(coloredLogs, "\n") {
+		t.Errorf("saved logs = %q, want the step's logs with colors", data)
+	}
+	if !strings.Contains(app.flashMsg, "Saved logs to "+path) {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
+
+func TestApp_SaveLogs_Overwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.log")
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	app := newLogFileTestApp()
+	app.confirmSaveLogs()
+	app.savingLogs.input.SetValue(path)
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	msgs := runCmds(app.handleKeyPress(enter))
+	if len(msgs) != 1 || !strings.Contains(msgs[0].(FlashMsg).Message, "press Enter again to overwrite") {
+		t.Errorf("msgs = %v, want a confirmation", msgs)
+	}
+
+	msgs = runCmds(app.handleKeyPress(enter))
+	if len(msgs) != 1 || msgs[0].(LogsSavedMsg).Err != nil {
+		t.Fatalf("msgs = %v, want the logs saved", msgs)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != stripANSI(coloredLogs) {
+		t.Errorf("saved logs = %q, want all logs without colors", data)
+	}
+}
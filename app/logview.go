@@ -1,6 +1,8 @@
 package app
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -14,9 +16,15 @@ const (
 // LogViewport wraps a viewport with autoscroll functionality.
 // It automatically scrolls to the bottom when new content is added,
 // unless the user has manually scrolled up.
+// A range of lines can be selected, from an anchor line to a cursor line.
 type LogViewport struct {
 	viewport   viewport.Model
 	autoscroll bool
+
+	lines     []string // Content lines, with colors
+	selecting bool
+	anchor    int // Line where the selection started
+	cursor    int // Line the selection extends to
 }
 
 // NewLogViewport creates a new LogViewport with the specified dimensions.
@@ -30,13 +38,36 @@ func NewLogViewport(width, height int) *LogViewport {
 
 // SetContent sets the content of the viewport.
 // If autoscroll is enabled, it will scroll to the bottom.
+// A selection is kept, shortened to the new content.
 func (lv *LogViewport) SetContent(content string) {
-	lv.viewport.SetContent(content)
-	if lv.autoscroll {
+	lv.lines = strings.Split(content, "\n")
+	if lv.selecting {
+		lv.anchor = min(lv.anchor, len(lv.lines)-1)
+		lv.cursor = min(lv.cursor, len(lv.lines)-1)
+	}
+	lv.render()
+	if lv.autoscroll && !lv.selecting {
 		lv.viewport.GotoBottom()
 	}
 }
 
+// render sets the viewport content, with the selected lines highlighted
+func (lv *LogViewport) render() {
+	if !lv.selecting {
+		lv.viewport.SetContent(strings.Join(lv.lines, "\n"))
+		return
+	}
+	from, to := lv.selectedRange()
+	lines := make([]string, len(lv.lines))
+	for i, line := range lv.lines {
+		if i >= from && i <= to {
+			line = SelectedItemFocused.Render(stripANSI(line))
+		}
+		lines[i] = line
+	}
+	lv.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
 // SetSize resizes the viewport.
 func (lv *LogViewport) SetSize(width, height int) {
 	lv.viewport.Width = width
@@ -86,3 +117,76 @@ func (lv *LogViewport) GotoTop() {
 	lv.viewport.GotoTop()
 	lv.autoscroll = false
 }
+
+// StartSelection starts selecting lines at the top line in view.
+func (lv *LogViewport) StartSelection() {
+	lv.selecting = true
+	lv.autoscroll = false
+	lv.anchor = min(lv.viewport.YOffset, len(lv.lines)-1)
+	lv.cursor = lv.anchor
+	lv.render()
+}
+
+// ClearSelection ends line selection.
+func (lv *LogViewport) ClearSelection() {
+	if !lv.selecting {
+		return
+	}
+	lv.selecting = false
+	lv.render()
+}
+
+// Selecting returns true if lines are being selected.
+func (lv *LogViewport) Selecting() bool {
+	return lv.selecting
+}
+
+// MoveCursor extends the selection by delta lines, scrolling to keep the cursor in view.
+func (lv *LogViewport) MoveCursor(delta int) {
+	lv.cursor = max(0, min(lv.cursor+delta, len(lv.lines)-1))
+	lv.render()
+	if lv.cursor < lv.viewport.YOffset {
+		lv.viewport.SetYOffset(lv.cursor)
+	} else if bottom := lv.viewport.YOffset + lv.viewport.Height - 1; lv.cursor > bottom {
+		lv.viewport.SetYOffset(lv.viewport.YOffset + lv.cursor - bottom)
+	}
+}
+
+// selectedRange returns the first and last selected lines
+func (lv *LogViewport) selectedRange() (int, int) {
+	return min(lv.anchor, lv.cursor), max(lv.anchor, lv.cursor)
+}
+
+// SelectedText returns the selected lines without colors or trailing spaces.
+func (lv *LogViewport) SelectedText() string {
+	if !lv.selecting {
+		return ""
+	}
+	from, to := lv.selectedRange()
+	lines := make([]string, 0, to-from+1)
+	for _, line := range lv.lines[from : to+1] {
+		lines = append(lines, strings.TrimRight(stripANSI(line), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// SelectedCount returns the number of selected lines.
+func (lv *LogViewport) SelectedCount() int {
+	if !lv.selecting {
+		return 0
+	}
+	from, to := lv.selectedRange()
+	return to - from + 1
+}
+
+// VisibleText returns the lines in view without colors or padding.
+func (lv *LogViewport) VisibleText() string {
+	lines := strings.Split(stripANSI(lv.viewport.View()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
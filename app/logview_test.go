@@ -294,3 +294,43 @@ func TestLogViewport_SetSizeMultipleTimes(t *testing.T) {
 		t.Error("expected non-empty view after multiple resizes")
 	}
 }
+
+// TestLogViewport_Selection tests selecting a range of lines.
+func TestLogViewport_Selection(t *testing.T) {
+	lv := NewLogViewport(80, 3)
+	lv.SetContent("\x1b[32mline 1\x1b[0m\nline 2  \nline 3\nline 4\nline 5")
+	lv.GotoTop()
+	lv.ScrollDown()
+
+	lv.StartSelection()
+	if !lv.Selecting() || lv.SelectedText() != "line 2" {
+		t.Fatalf("SelectedText() = %q, want the top line in view", lv.SelectedText())
+	}
+
+	// The cursor scrolls into view
+	lv.MoveCursor(3)
+	if got := lv.SelectedText(); got != "line 2\nline 3\nline 4\nline 5" {
+		t.Errorf("SelectedText() = %q", got)
+	}
+	if !strings.Contains(lv.View(), "line 5") {
+		t.Errorf("cursor line should be in view:\n%s", lv.View())
+	}
+
+	// Moving up past the anchor selects upwards
+	lv.MoveCursor(-10)
+	if got := lv.SelectedText(); got != "line 1\nline 2" || lv.SelectedCount() != 2 {
+		t.Errorf("SelectedText() = %q, want colors and trailing spaces removed", got)
+	}
+
+	// New content shortens the selection
+	lv.MoveCursor(4)
+	lv.SetContent("line 1\nline 2\nline 3")
+	if got := lv.SelectedText(); got != "line 2\nline 3" {
+		t.Errorf("SelectedText() after SetContent = %q", got)
+	}
+
+	lv.ClearSelection()
+	if lv.Selecting() || lv.SelectedText() != "" {
+		t.Error("ClearSelection() should end the selection")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/lint"
 )
 
 // === Data Loading Results ===
@@ -16,14 +17,58 @@ type WorkflowsLoadedMsg struct {
 
 // RunsLoadedMsg is sent when workflow runs have been fetched from GitHub.
 type RunsLoadedMsg struct {
-	Runs []github.Run
-	Err  error
+	WorkflowID int64 // Workflow the runs were fetched for
+	Runs       []github.Run
+	Err        error
+}
+
+// AllRunsLoadedMsg is sent when every run of a workflow has been listed.
+type AllRunsLoadedMsg struct {
+	WorkflowID int64
+	Runs       []github.Run
+	Err        error
 }
 
 // JobsLoadedMsg is sent when jobs have been fetched from GitHub.
 type JobsLoadedMsg struct {
-	Jobs []github.Job
-	Err  error
+	RunID   int64 // Run the jobs were fetched for
+	Attempt int   // Attempt the jobs were fetched for, 0 for the latest
+	Jobs    []github.Job
+	Err     error
+}
+
+// RunAttemptLoadedMsg is sent when an earlier attempt of a run has been fetched from GitHub.
+type RunAttemptLoadedMsg struct {
+	RunID   int64
+	Attempt int
+	Run     *github.Run
+	Err     error
+}
+
+// WorkflowStatsLoadedMsg is sent when the recent runs of a workflow have been fetched for its statistics.
+type WorkflowStatsLoadedMsg struct {
+	WorkflowID    int64
+	Runs          []github.Run
+	DefaultBranch string
+	Err           error
+}
+
+// FlakyJobsLoadedMsg is sent when the jobs of every attempt of recent runs have been fetched
+// to detect flaky jobs.
+type FlakyJobsLoadedMsg struct {
+	WorkflowID int64
+	Attempts   []attemptJobs
+	SHAs       int // Commits of the recent runs
+	Err        error
+}
+
+// UsageLoadedMsg is sent when the billable usage of a workflow or run has been fetched from GitHub.
+// Exactly one of WorkflowID and RunID is set.
+type UsageLoadedMsg struct {
+	WorkflowID int64
+	RunID      int64
+	Usage      *github.Usage
+	Err        error
 }
 
 // LogsLoadedMsg is sent when job logs have been fetched from GitHub.
@@ -33,6 +78,69 @@ type LogsLoadedMsg struct {
 	Err   error
 }
 
+// TerminalOutputMsg carries raw output to write to the program's terminal.
+type TerminalOutputMsg struct {
+	Data []byte
+}
+
+// LogViewerClosedMsg is sent when the pager or editor showing logs from a temp file exits.
+type LogViewerClosedMsg struct {
+	Path string
+	Err  error
+}
+
+// LogsSavedMsg is sent when logs have been saved to a file.
+type LogsSavedMsg struct {
+	Path string
+	Err  error
+}
+
+// LogDiffLoadedMsg is sent when the logs of a job and of the job of the same name
+// in the compare base have been fetched and compared.
+type LogDiffLoadedMsg struct {
+	JobID int64
+	Lines []diffLine
+	Err   error
+}
+
+// WorkflowDefinitionLoadedMsg is sent when a workflow file has been read at a ref,
+// along with its working copy if there is a local clone.
+type WorkflowDefinitionLoadedMsg struct {
+	Path       string
+	Ref        string
+	Content    string
+	Source     string // Local git or GitHub
+	Working    string
+	HasWorking bool
+	Err        error
+}
+
+// LintLoadedMsg is sent when the local workflow files have been linted.
+type LintLoadedMsg struct {
+	Issues []lint.Issue
+	Err    error
+}
+
+// WatchedRunsLoadedMsg is sent when the watched runs have been fetched from GitHub.
+type WatchedRunsLoadedMsg struct {
+	Runs []github.Run
+	Err  error
+}
+
+// PendingDeploymentsLoadedMsg is sent when the pending deployments of a run have been fetched.
+type PendingDeploymentsLoadedMsg struct {
+	RunID       int64
+	Deployments []github.PendingDeployment
+	Err         error
+}
+
+// RetryScheduledMsg is sent when a fetch will be retried after a wait.
+type RetryScheduledMsg struct {
+	Attempt int
+	Wait    time.Duration
+	Err     error
+}
+
 // === Action Results ===
 
 // RunCancelledMsg is sent when a workflow run has been cancelled.
@@ -41,15 +149,30 @@ type RunCancelledMsg struct {
 	Err   error
 }
 
+// RunForceCancelledMsg is sent when a workflow run has been force-cancelled.
+type RunForceCancelledMsg struct {
+	RunID int64
+	Err   error
+}
+
 // RunRerunMsg is sent when a workflow run has been rerun.
 type RunRerunMsg struct {
 	RunID int64
+	Debug bool // Rerun with debug logging
 	Err   error
 }
 
 // RerunFailedJobsMsg is sent when failed jobs have been rerun.
 type RerunFailedJobsMsg struct {
 	RunID int64
+	Debug bool // Rerun with debug logging
+	Err   error
+}
+
+// JobRerunMsg is sent when a single job has been rerun.
+type JobRerunMsg struct {
+	JobID int64
+	Debug bool // Rerun with debug logging
 	Err   error
 }
 
@@ -59,6 +182,40 @@ type WorkflowTriggeredMsg struct {
 	Err      error
 }
 
+// RunDeletedMsg is sent when a run, or only its logs, has been deleted.
+type RunDeletedMsg struct {
+	RunID int64
+	Err   error
+}
+
+// RunFailure records a run for which a bulk action failed.
+type RunFailure struct {
+	Run github.Run
+	Err error
+}
+
+// BulkActionMsg is sent when an action on several runs has finished.
+type BulkActionMsg struct {
+	Action   string // e.g., "Cancel"
+	Runs     []github.Run
+	Failures []RunFailure // Ordered by run number
+}
+
+// WorkflowStateChangedMsg is sent when a workflow has been enabled or disabled.
+type WorkflowStateChangedMsg struct {
+	Workflow string
+	Enabled  bool
+	Err      error
+}
+
+// DeploymentsReviewedMsg is sent when pending deployments have been approved or rejected.
+type DeploymentsReviewedMsg struct {
+	RunID        int64
+	Environments []string
+	Approved     bool
+	Err          error
+}
+
 // === UI State ===
 
 // FlashMsg is sent to display a temporary message to the user.
@@ -75,6 +232,11 @@ type TickMsg struct {
 	Time time.Time
 }
 
+// ReconnectMsg is sent when it is time to retry loading while offline.
+type ReconnectMsg struct {
+	Time time.Time
+}
+
 // === Window events ===
 
 // WindowSizeMsg is sent when the terminal window size changes.
@@ -12,7 +12,7 @@ func (a *App) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	a.mouseY = msg.Y
 
 	// Ignore actions when popups are shown
-	if a.showHelp || a.showConfirm || a.fullscreenLog || a.filtering {
+	if a.showHelp || a.showConfirm || a.review != nil || a.deleting != nil || a.flakyList != nil || a.logDiff != nil || a.fullscreenLog || a.filtering {
 		return a, nil
 	}
 
@@ -70,7 +70,12 @@ func (a *App) handleClick(x, y int) (tea.Model, tea.Cmd) {
 }
 
 // handleDetailPanelClick handles mouse clicks in the detail panel (right side)
-func (a *App) handleDetailPanelClick(_, y, _, _ int) (tea.Model, tea.Cmd) {
+func (a *App) handleDetailPanelClick(x, y, leftWidth, _ int) (tea.Model, tea.Cmd) {
+	// In the Graph tab, clicking a job selects it; content starts after the border
+	if a.detailTab == GraphTab {
+		return a, a.clickGraph(x-leftWidth-1, y-1)
+	}
+
 	// Only handle clicks in Logs tab with step list
 	if a.detailTab != LogsTab || a.parsedLogs == nil || len(a.parsedLogs.Steps) == 0 {
 		return a, nil
@@ -98,6 +103,7 @@ func (a *App) handleDetailPanelClick(_, y, _, _ int) (tea.Model, tea.Cmd) {
 		if clickedIdx >= -1 && clickedIdx < stepCount {
 			a.selectedStepIdx = clickedIdx
 			a.stepListFocused = true
+			a.logView.ClearSelection()
 			a.updateLogViewContent()
 			a.logView.GotoTop()
 		}
@@ -121,6 +127,11 @@ func (a *App) handleScrollUp() (tea.Model, tea.Cmd) {
 		return a, nil
 	}
 
+	if a.mouseX >= leftWidth && a.detailTab == WorkflowTab {
+		a.scrollDefinition(-ScrollLineCount)
+		return a, nil
+	}
+
 	// Otherwise, scroll the focused left panel
 	switch a.focusedPane {
 	case WorkflowsPane:
@@ -151,6 +162,11 @@ func (a *App) handleScrollDown() (tea.Model, tea.Cmd) {
 		return a, nil
 	}
 
+	if a.mouseX >= leftWidth && a.detailTab == WorkflowTab {
+		a.scrollDefinition(ScrollLineCount)
+		return a, nil
+	}
+
 	// Otherwise, scroll the focused left panel
 	switch a.focusedPane {
 	case WorkflowsPane:
@@ -168,18 +184,26 @@ func (a *App) handleScrollDown() (tea.Model, tea.Cmd) {
 
 // onWorkflowSelectionChange handles workflow selection change
 func (a *App) onWorkflowSelectionChange() tea.Cmd {
+	// Jobs, logs, statistics and flaky jobs of the previous workflow are no longer needed
+	cancelRequest(&a.cancelJobs)
+	cancelRequest(&a.cancelLogs)
+	cancelRequest(&a.cancelStats)
+	a.cancelFlakyDetection()
 	if wf, ok := a.workflows.Selected(); ok {
 		a.loading = true
-		return a.fetchRunsCmd(wf.ID)
+		return tea.Batch(a.fetchRunsCmd(wf.ID), a.fetchUsageCmd(), a.fetchStatsCmd(), a.fetchFlakyCmd())
 	}
 	return nil
 }
 
 // onRunSelectionChange handles run selection change
 func (a *App) onRunSelectionChange() tea.Cmd {
+	// Logs of the previous run are no longer needed
+	cancelRequest(&a.cancelLogs)
+	a.resetAttempt()
 	if run, ok := a.runs.Selected(); ok {
 		a.loading = true
-		return a.fetchJobsCmd(run.ID)
+		return tea.Batch(a.fetchJobsCmd(run.ID), a.fetchUsageCmd(), a.fetchDefinitionCmd())
 	}
 	return nil
 }
@@ -195,9 +219,11 @@ func (a *App) onJobSelectionChange() tea.Cmd {
 	a.parsedLogs = nil
 	a.selectedStepIdx = -1
 	a.stepListFocused = true
+	a.logView.ClearSelection()
 
 	// GitHub API only provides logs for completed jobs
 	if !job.IsCompleted() {
+		cancelRequest(&a.cancelLogs)
 		a.logView.SetContent(jobStatusMessage(job))
 		return nil
 	}
@@ -240,6 +266,7 @@ func (a *App) navigateStepUp() {
 	// -1 is "All logs", 0 to len-1 are specific steps
 	if a.selectedStepIdx > -1 {
 		a.selectedStepIdx--
+		a.logView.ClearSelection()
 		a.updateLogViewContent()
 		a.logView.GotoTop()
 	}
@@ -253,6 +280,7 @@ func (a *App) navigateStepDown() {
 	maxIdx := len(a.parsedLogs.Steps) - 1
 	if a.selectedStepIdx < maxIdx {
 		a.selectedStepIdx++
+		a.logView.ClearSelection()
 		a.updateLogViewContent()
 		a.logView.GotoTop()
 	}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Offline handling - keep the last data visible and reconnect with backoff
+
+// handleLoadError records a failed fetch.
+// Network errors switch to offline mode and schedule a reconnect;
+// other errors are shown in the status bar as before.
+func (a *App) handleLoadError(err error) tea.Cmd {
+	if !github.IsNetworkError(err) {
+		a.err = err
+		return nil
+	}
+
+	if !a.offline {
+		a.offline = true
+		a.reconnectDelay = ReconnectInitialDelay
+	}
+	return a.scheduleReconnect()
+}
+
+// markOnline records a successful fetch and leaves offline mode
+func (a *App) markOnline(now time.Time) {
+	a.offline = false
+	a.reconnectDelay = 0
+	a.lastUpdated = now
+}
+
+// scheduleReconnect schedules a reconnect attempt after the current backoff delay.
+// Only one reconnect is pending at a time.
+func (a *App) scheduleReconnect() tea.Cmd {
+	if a.reconnectPending {
+		return nil
+	}
+	a.reconnectPending = true
+	a.reconnectAt = time.Now().Add(a.reconnectDelay)
+	return tea.Tick(a.reconnectDelay, func(t time.Time) tea.Msg {
+		return ReconnectMsg{Time: t}
+	})
+}
+
+// handleReconnect retries loading while offline, doubling the delay for the next attempt
+func (a *App) handleReconnect() tea.Cmd {
+	a.reconnectPending = false
+	if !a.offline {
+		return nil
+	}
+
+	a.reconnectDelay *= 2
+	if a.reconnectDelay > ReconnectMaxDelay {
+		a.reconnectDelay = ReconnectMaxDelay
+	}
+	return a.refreshAll()
+}
+
+// reconnectNow skips the pending backoff and retries immediately
+func (a *App) reconnectNow() tea.Cmd {
+	a.reconnectDelay = ReconnectInitialDelay
+	return a.refreshAll()
+}
+
+// offlineStatus returns the offline banner text with the staleness of the shown data
+func (a *App) offlineStatus(now time.Time) string {
+	text := "Offline"
+	if !a.lastUpdated.IsZero() {
+		text += " - data from " + a.lastUpdated.Format("15:04:05") +
+			" (" + formatCountdown(now.Sub(a.lastUpdated)) + " ago)"
+	}
+	if a.reconnectPending && now.Before(a.reconnectAt) {
+		text += " - reconnecting in " + formatCountdown(a.reconnectAt.Sub(now))
+	} else {
+		text += " - reconnecting..."
+	}
+	return text + " [Esc]retry now"
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+var errNetwork = &github.AppError{Type: github.ErrTypeNetwork, Message: "Network unavailable", Retryable: true}
+
+func TestApp_NetworkErrorGoesOffline(t *testing.T) {
+	app := New()
+	app.width = 120
+	app.height = 40
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+
+	_, cmd := app.Update(WorkflowsLoadedMsg{Err: errNetwork})
+
+	if !app.offline {
+		t.Fatal("app should be offline after a network error")
+	}
+	if app.err != nil {
+		t.Errorf("err = %v, network errors should not be shown as errors", app.err)
+	}
+	if cmd == nil {
+		t.Error("expected a reconnect to be scheduled")
+	}
+	if !app.reconnectPending {
+		t.Error("reconnect should be pending")
+	}
+	if app.workflows.Len() != 1 {
+		t.Error("last data should stay visible while offline")
+	}
+	if !strings.Contains(app.renderStatusBar(), "Offline") {
+		t.Error("status bar should show the offline banner")
+	}
+}
+
+func TestApp_NonNetworkErrorSetsErr(t *testing.T) {
+	app := New()
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+
+	app.Update(RunsLoadedMsg{WorkflowID: 1, Err: errAPI})
+
+	if app.offline {
+		t.Error("app should not go offline for API errors")
+	}
+	if app.err == nil {
+		t.Error("err should be set for API errors")
+	}
+}
+
+func TestApp_ScheduleReconnect_OnlyOnce(t *testing.T) {
+	app := New()
+
+	if cmd := app.handleLoadError(errNetwork); cmd == nil {
+		t.Fatal("first network error should schedule a reconnect")
+	}
+	if cmd := app.handleLoadError(errNetwork); cmd != nil {
+		t.Error("second network error should not schedule another reconnect")
+	}
+}
+
+func TestApp_HandleReconnect_Backoff(t *testing.T) {
+	app := New(WithClient(newMockClient(nil)))
+	app.handleLoadError(errNetwork)
+
+	if app.reconnectDelay != ReconnectInitialDelay {
+		t.Fatalf("reconnectDelay = %v, want %v", app.reconnectDelay, ReconnectInitialDelay)
+	}
+
+	if cmd := app.handleReconnect(); cmd == nil {
+		t.Error("reconnect should refresh data")
+	}
+	if app.reconnectDelay != 2*ReconnectInitialDelay {
+		t.Errorf("reconnectDelay = %v, want %v", app.reconnectDelay, 2*ReconnectInitialDelay)
+	}
+
+	app.reconnectDelay = ReconnectMaxDelay
+	app.handleReconnect()
+	if app.reconnectDelay != ReconnectMaxDelay {
+		t.Errorf("reconnectDelay = %v, want capped at %v", app.reconnectDelay, ReconnectMaxDelay)
+	}
+}
+
+func TestApp_SuccessfulLoadGoesOnline(t *testing.T) {
+	app := New()
+	app.handleLoadError(errNetwork)
+
+	before := time.Now()
+	app.Update(WorkflowsLoadedMsg{Workflows: []github.Workflow{{ID: 1, Name: "CI"}}})
+
+	if app.offline {
+		t.Error("app should be online after a successful load")
+	}
+	if app.lastUpdated.Before(before) {
+		t.Error("lastUpdated should be set on successful load")
+	}
+	if cmd := app.handleReconnect(); cmd != nil {
+		t.Error("pending reconnect should do nothing once online")
+	}
+}
+
+func TestApp_OfflineStatus(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 5, 0, 0, time.Local)
+	app := New()
+	app.offline = true
+	app.lastUpdated = now.Add(-3 * time.Minute)
+	app.reconnectPending = true
+	app.reconnectAt = now.Add(8 * time.Second)
+
+	status := app.offlineStatus(now)
+	for _, want := range []string{"Offline", "data from 10:02:00", "3m ago", "reconnecting in 8s"} {
+		if !strings.Contains(status, want) {
+			t.Errorf("offlineStatus() = %q, want to contain %q", status, want)
+		}
+	}
+}
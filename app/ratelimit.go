@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Rate limit awareness - status bar meter and background refresh throttling
+
+// syncRateLimit refreshes the rate limit snapshot from the client
+func (a *App) syncRateLimit() {
+	if a.client == nil {
+		return
+	}
+	a.rateLimit = a.client.RateLimit()
+}
+
+// isThrottled returns true if background refreshes should be deferred
+// so that the remaining quota is kept for user-initiated actions
+func (a *App) isThrottled(now time.Time) bool {
+	return a.rateLimit.IsCritical() && now.Before(a.rateLimit.Reset)
+}
+
+// nextPollInterval returns how long to wait before the next background refresh.
+// When throttled, the refresh is deferred until the rate limit resets.
+func (a *App) nextPollInterval(now time.Time) time.Duration {
+	if a.isThrottled(now) {
+		if wait := a.rateLimit.Reset.Sub(now); wait > PollInterval {
+			return wait
+		}
+	}
+	return PollInterval
+}
+
+// schedulePoll schedules the next background refresh
+func (a *App) schedulePoll(now time.Time) tea.Cmd {
+	return tick(a.nextPollInterval(now))
+}
+
+// handlePollTick refreshes the current workflow unless throttled, offline or already loading,
+// and checks on watched runs. While offline the reconnect backoff drives refreshes instead.
+func (a *App) handlePollTick(now time.Time) tea.Cmd {
+	var refresh, watch tea.Cmd
+	if !a.isThrottled(now) && !a.offline {
+		if !a.loading {
+			refresh = a.refreshCurrentWorkflow()
+		}
+		watch = a.fetchWatchedRunsCmd()
+	}
+	return tea.Batch(refresh, watch, a.schedulePoll(now))
+}
+
+// retryNotifier returns a callback that reports retry waits to the UI.
+// Sends never block so a command cannot hang if nobody is listening.
+func (a *App) retryNotifier() github.RetryNotifyFunc {
+	ch := a.retryCh
+	return func(attempt int, wait time.Duration, err error) {
+		select {
+		case ch <- RetryScheduledMsg{Attempt: attempt, Wait: wait, Err: err}:
+		default:
+		}
+	}
+}
+
+// clearRetry clears the pending retry countdown
+func (a *App) clearRetry() {
+	a.retryUntil = time.Time{}
+	a.retryErr = nil
+}
+
+// retryStatus returns the retry countdown text, or "" if no retry is pending
+func (a *App) retryStatus(now time.Time) string {
+	if a.retryUntil.IsZero() || !now.Before(a.retryUntil) {
+		return ""
+	}
+	text := "Retrying in " + formatCountdown(a.retryUntil.Sub(now))
+	if a.retryErr != nil {
+		text += " (" + a.retryErr.Error() + ")"
+	}
+	return text
+}
+
+// renderRateLimitMeter renders the rate limit meter for the status bar.
+// Returns an empty string until the rate limit is known.
+func renderRateLimitMeter(rate github.RateLimit, now time.Time) string {
+	if rate.Limit <= 0 {
+		return ""
+	}
+
+	text := fmt.Sprintf("API %d/%d", rate.Remaining, rate.Limit)
+	if rate.IsLow() && now.Before(rate.Reset) {
+		text += " reset " + formatCountdown(rate.Reset.Sub(now))
+	}
+
+	switch {
+	case rate.IsCritical():
+		return RateLimitCriticalStyle.Render(text)
+	case rate.IsLow():
+		return RateLimitLowStyle.Render(text)
+	default:
+		return RateLimitOKStyle.Render(text)
+	}
+}
+
+// formatCountdown formats a duration as a compact countdown (e.g., "1h5m", "12m", "45s")
+func formatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	d = d.Round(time.Second)
+	switch {
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
@@ -0,0 +1,223 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func TestApp_SyncRateLimit(t *testing.T) {
+	app := New(WithClient(newMockClient(&mockClientState{rateLimit: 120})))
+
+	app.Update(WorkflowsLoadedMsg{})
+
+	if app.rateLimit.Remaining != 120 {
+		t.Errorf("rateLimit.Remaining = %d, want 120", app.rateLimit.Remaining)
+	}
+}
+
+func TestApp_IsThrottled(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rate github.RateLimit
+		want bool
+	}{
+		{name: "unknown", rate: github.RateLimit{}, want: false},
+		{name: "healthy", rate: github.RateLimit{Limit: 5000, Remaining: 4000, Reset: now.Add(time.Hour)}, want: false},
+		{name: "low but not critical", rate: github.RateLimit{Limit: 5000, Remaining: 800, Reset: now.Add(time.Hour)}, want: false},
+		{name: "critical before reset", rate: github.RateLimit{Limit: 5000, Remaining: 10, Reset: now.Add(10 * time.Minute)}, want: true},
+		{name: "critical after reset", rate: github.RateLimit{Limit: 5000, Remaining: 10, Reset: now.Add(-time.Minute)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			app.rateLimit = tt.rate
+			if got := app.isThrottled(now); got != tt.want {
+				t.Errorf("isThrottled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApp_NextPollInterval(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("default interval when healthy", func(t *testing.T) {
+		app := New()
+		app.rateLimit = github.RateLimit{Limit: 5000, Remaining: 4000, Reset: now.Add(time.Hour)}
+		if got := app.nextPollInterval(now); got != PollInterval {
+			t.Errorf("nextPollInterval() = %v, want %v", got, PollInterval)
+		}
+	})
+
+	t.Run("deferred until reset when throttled", func(t *testing.T) {
+		app := New()
+		app.rateLimit = github.RateLimit{Limit: 5000, Remaining: 10, Reset: now.Add(20 * time.Minute)}
+		if got := app.nextPollInterval(now); got != 20*time.Minute {
+			t.Errorf("nextPollInterval() = %v, want 20m", got)
+		}
+	})
+}
+
+func TestApp_HandlePollTick(t *testing.T) {
+	now := time.Now()
+
+	t.Run("refreshes runs when healthy", func(t *testing.T) {
+		state := &mockClientState{workflows: []github.Workflow{{ID: 1, Name: "CI"}}}
+		mock := newMockClient(state)
+		app := New(WithClient(mock))
+		app.workflows.SetItems(state.workflows)
+		app.rateLimit = github.RateLimit{Limit: 5000, Remaining: 4000, Reset: now.Add(time.Hour)}
+
+		cmd := app.handlePollTick(now)
+		if cmd == nil {
+			t.Fatal("handlePollTick() returned nil")
+		}
+		// Refresh and next poll are batched together
+		if _, ok := cmd().(tea.BatchMsg); !ok {
+			t.Error("expected refresh to be batched with the next poll")
+		}
+	})
+
+	t.Run("skips refresh when throttled", func(t *testing.T) {
+		app := New()
+		app.rateLimit = github.RateLimit{Limit: 5000, Remaining: 10, Reset: now.Add(time.Hour)}
+
+		if !app.isThrottled(now) {
+			t.Fatal("expected app to be throttled")
+		}
+		if cmd := app.handlePollTick(now); cmd == nil {
+			t.Error("handlePollTick() should still schedule the next poll")
+		}
+	})
+}
+
+func TestApp_PollKeepsSelectedRun(t *testing.T) {
+	runs := []github.Run{{ID: 10, Status: "completed"}, {ID: 20, Status: "completed"}}
+	state := &mockClientState{
+		workflows: []github.Workflow{{ID: 1, Name: "CI"}},
+		runs:      runs,
+		jobs:      []github.Job{{ID: 200, Name: "test", Status: "completed", Conclusion: "success"}},
+	}
+	app := New(WithClient(newMockClient(state)))
+	app.workflows.SetItems(state.workflows)
+	app.Update(RunsLoadedMsg{WorkflowID: 1, Runs: runs})
+	app.runs.SelectNext()
+	app.Update(JobsLoadedMsg{RunID: 20, Jobs: state.jobs})
+	app.Update(LogsLoadedMsg{JobID: 200, Logs: "logs of run 20"})
+
+	// A poll brings in a new run at the top
+	_, cmd := app.Update(RunsLoadedMsg{WorkflowID: 1, Runs: append([]github.Run{{ID: 30, Status: "in_progress"}}, runs...)})
+	if run, _ := app.runs.Selected(); run.ID != 20 {
+		t.Fatalf("selected run = %d, want 20 to stay selected", run.ID)
+	}
+	for _, msg := range runCmds(cmd) {
+		if jobs, ok := msg.(JobsLoadedMsg); ok && jobs.RunID != 20 {
+			t.Errorf("jobs fetched for run %d, want 20", jobs.RunID)
+		}
+	}
+	if app.parsedLogs == nil || app.parsedLogs.RawLogs != "logs of run 20" {
+		t.Error("logs of the selected job should stay shown")
+	}
+
+	// When the selected run is gone, the logs of its job are dropped with it
+	app.Update(RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{{ID: 30, Status: "in_progress"}, {ID: 40, Status: "completed"}}})
+	app.Update(JobsLoadedMsg{RunID: 40, Jobs: []github.Job{{ID: 400, Name: "test", Status: "completed"}}})
+	if app.parsedLogs != nil {
+		t.Error("logs of the previous job should not be shown under another job")
+	}
+}
+
+func TestRenderRateLimitMeter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("hidden when unknown", func(t *testing.T) {
+		if got := renderRateLimitMeter(github.RateLimit{}, now); got != "" {
+			t.Errorf("renderRateLimitMeter() = %q, want empty", got)
+		}
+	})
+
+	t.Run("shows remaining and limit", func(t *testing.T) {
+		got := renderRateLimitMeter(github.RateLimit{Limit: 5000, Remaining: 4321, Reset: now.Add(time.Hour)}, now)
+		if !strings.Contains(got, "API 4321/5000") {
+			t.Errorf("renderRateLimitMeter() = %q, want to contain %q", got, "API 4321/5000")
+		}
+		if strings.Contains(got, "reset") {
+			t.Errorf("renderRateLimitMeter() = %q, should not show reset when healthy", got)
+		}
+	})
+
+	t.Run("shows reset countdown when low", func(t *testing.T) {
+		got := renderRateLimitMeter(github.RateLimit{Limit: 5000, Remaining: 50, Reset: now.Add(12 * time.Minute)}, now)
+		if !strings.Contains(got, "reset 12m") {
+			t.Errorf("renderRateLimitMeter() = %q, want to contain %q", got, "reset 12m")
+		}
+	})
+}
+
+func TestFormatCountdown(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: -time.Second, want: "0s"},
+		{d: 45 * time.Second, want: "45s"},
+		{d: 12*time.Minute + 30*time.Second, want: "12m"},
+		{d: time.Hour + 5*time.Minute, want: "1h5m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatCountdown(tt.d); got != tt.want {
+				t.Errorf("formatCountdown(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApp_RetryScheduledMsg(t *testing.T) {
+	app := New()
+	app.width = 120
+	app.height = 40
+
+	app.Update(RetryScheduledMsg{Attempt: 1, Wait: 37 * time.Second, Err: errAPI})
+
+	status := app.retryStatus(time.Now())
+	if !strings.Contains(status, "Retrying in 3") {
+		t.Errorf("retryStatus() = %q, want countdown", status)
+	}
+	if !strings.Contains(app.renderStatusBar(), "Retrying in") {
+		t.Error("status bar should show the retry countdown")
+	}
+
+	// Unrelated results leave the countdown, the retried fetch clears it
+	app.Update(UsageLoadedMsg{WorkflowID: 1})
+	app.Update(WorkflowDefinitionLoadedMsg{Path: ".github/workflows/ci.yml"})
+	if got := app.retryStatus(time.Now()); got == "" {
+		t.Error("retryStatus() should survive unrelated results")
+	}
+	app.Update(WorkflowsLoadedMsg{})
+	if got := app.retryStatus(time.Now()); got != "" {
+		t.Errorf("retryStatus() after load = %q, want empty", got)
+	}
+}
+
+func TestApp_RetryNotifier_DoesNotBlock(t *testing.T) {
+	app := New()
+	notify := app.retryNotifier()
+
+	// The channel is buffered for one message; further sends must be dropped
+	notify(1, time.Second, errAPI)
+	notify(2, 2*time.Second, errAPI)
+
+	msg := <-app.retryCh
+	if msg.Attempt != 1 || msg.Wait != time.Second {
+		t.Errorf("retry message = %+v, want attempt 1 with 1s wait", msg)
+	}
+}
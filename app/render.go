@@ -3,6 +3,7 @@ package app
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -36,6 +37,10 @@ func (a *App) buildWorkflowsPanel(width, height int) []string {
 			selected := i == a.workflows.SelectedIndex()
 			hovered := a.mouseX < leftWidth && a.mouseY == i+BorderOffset
 			name := truncateString(wf.Name, width-ItemPaddingSmall)
+			if wf.IsDisabled() && !selected {
+				content = append(content, DisabledItem.Render("  "+name))
+				continue
+			}
 			content = append(content, a.renderListItem(name, selected, focused, hovered))
 		}
 	}
@@ -47,13 +52,21 @@ func (a *App) buildWorkflowsPanel(width, height int) []string {
 func (a *App) buildRunsPanel(width, height int) []string {
 	focused := a.focusedPane == RunsPane
 	borderStyle := getPanelBorderStyle(focused)
-	title := renderPanelTitle("Runs", focused)
+	titleText := "Runs"
+	if n := a.runs.MarkedCount(); n > 0 {
+		titleText += " (" + strconv.Itoa(n) + " marked)"
+	}
+	if a.runs.InVisual() {
+		titleText += " -- VISUAL --"
+	}
+	title := renderPanelTitle(titleText, focused)
 
 	// Calculate panel position for hover detection
 	leftWidth := a.leftPanelWidth()
 	panelStartY := a.panelStartY(RunsPane)
 
 	// Build content
+	now := time.Now()
 	var content []string
 	items := a.runs.Items()
 	if len(items) == 0 {
@@ -64,7 +77,23 @@ func (a *App) buildRunsPanel(width, height int) []string {
 			hovered := a.mouseX < leftWidth && a.mouseY == panelStartY+i+BorderOffset
 			icon := StatusIcon(run.Status, run.Conclusion)
 			line := icon + " #" + strconv.Itoa(run.RunNumber) + " " + run.Event + " " + run.Branch
-			line = truncateString(line, width-ItemPaddingSmall)
+			if run.IsWaiting() {
+				line = icon + " #" + strconv.Itoa(run.RunNumber) + " " + a.waitingBadge(run)
+			}
+			if badge := a.cancelBadge(run, now); badge != "" {
+				line = CancelledStyle.Render("⊘") + " #" + strconv.Itoa(run.RunNumber) + " " + badge
+			}
+			if a.runs.IsMarked(run) {
+				line = MarkStyle.Render("+") + line
+			}
+			if a.compareBase != nil && a.compareBase.ID == run.ID {
+				line = CompareBaseStyle.Render("=") + line
+			}
+			if a.isWatched(run) {
+				line = truncateString(line, width-ItemPaddingSmall-2) + " " + WatchStyle.Render("◉")
+			} else {
+				line = truncateString(line, width-ItemPaddingSmall)
+			}
 			content = append(content, a.renderListItem(line, selected, focused, hovered))
 		}
 	}
@@ -76,7 +105,13 @@ func (a *App) buildRunsPanel(width, height int) []string {
 func (a *App) buildJobsPanel(width, height int) []string {
 	focused := a.focusedPane == JobsPane
 	borderStyle := getPanelBorderStyle(focused)
-	title := renderPanelTitle("Jobs", focused)
+	titleText := "Jobs"
+	if run, ok := a.runs.Selected(); ok {
+		if label := a.attemptLabel(run); label != "" {
+			titleText += " (" + label + ")"
+		}
+	}
+	title := renderPanelTitle(titleText, focused)
 
 	// Calculate panel position for hover detection
 	leftWidth := a.leftPanelWidth()
@@ -93,6 +128,9 @@ func (a *App) buildJobsPanel(width, height int) []string {
 			hovered := a.mouseX < leftWidth && a.mouseY == panelStartY+i+BorderOffset
 			icon := StatusIcon(job.Status, job.Conclusion)
 			line := icon + " " + truncateString(job.Name, width-ItemPaddingMedium)
+			if a.isFlakyJob(job.Name) {
+				line = icon + " " + truncateString(job.Name, width-ItemPaddingMedium-2) + " " + FlakyStyle.Render(FlakyIcon)
+			}
 			content = append(content, a.renderListItem(line, selected, focused, hovered))
 		}
 	}
@@ -107,18 +145,30 @@ func (a *App) buildDetailPanel(width, height int) []string {
 	// Build tab header
 	infoTab := " Info "
 	logsTab := " Logs "
-	if a.detailTab == InfoTab {
-		infoTab = FocusedTitle.Render(" Info ")
-	} else {
-		logsTab = FocusedTitle.Render(" Logs ")
+	workflowTab := " Workflow "
+	graphTab := " Graph "
+	switch a.detailTab {
+	case InfoTab:
+		infoTab = FocusedTitle.Render(infoTab)
+	case WorkflowTab:
+		workflowTab = FocusedTitle.Render(workflowTab)
+	case GraphTab:
+		graphTab = FocusedTitle.Render(graphTab)
+	default:
+		logsTab = FocusedTitle.Render(logsTab)
 	}
-	tabHeader := " [1]" + infoTab + " [2]" + logsTab + " "
+	tabHeader := " [1]" + infoTab + " [2]" + logsTab + " [3]" + workflowTab + " [4]" + graphTab + " "
 
 	// Build content based on selected tab
 	var content []string
-	if a.detailTab == InfoTab {
+	switch a.detailTab {
+	case InfoTab:
 		content = a.buildInfoContent(width - ContentPadding)
-	} else {
+	case WorkflowTab:
+		content = a.buildDefinitionContent(width - ContentPadding)
+	case GraphTab:
+		content = a.buildGraphContent(width - ContentPadding)
+	default:
 		content = a.buildLogsContent(width - ContentPadding)
 	}
 
@@ -137,15 +187,27 @@ func (a *App) buildInfoContent(maxWidth int) []string {
 			content = append(content, "  Name:  "+wf.Name)
 			content = append(content, "  Path:  "+wf.Path)
 			content = append(content, "  State: "+wf.State)
+			if reason := wf.DisabledReason(); reason != "" {
+				content = append(content, "")
+				content = append(content, "  "+truncateString(reason, maxWidth-4))
+			}
+			content = append(content, a.buildLintContent(wf.Path, maxWidth)...)
+			content = append(content, a.buildStatsContent(wf.ID, maxWidth)...)
+			state, known := a.workflowUsage[wf.ID]
+			content = append(content, a.buildUsageContent("Billable time (this billing cycle)", state, known)...)
 		} else {
 			content = append(content, "  Select a workflow")
 		}
 
 	case RunsPane:
-		if run, ok := a.runs.Selected(); ok {
+		if selected, ok := a.runs.Selected(); ok {
+			run := a.attemptDetails(selected)
 			content = append(content, "  Run Information")
 			content = append(content, "  "+strings.Repeat("─", 30))
 			content = append(content, "  Run:    #"+strconv.Itoa(run.RunNumber))
+			if selected.Attempt > 1 {
+				content = append(content, "  Attempt: "+strconv.Itoa(a.shownAttempt(selected))+" of "+strconv.Itoa(selected.Attempt)+"  ([/] switch)")
+			}
 			content = append(content, "  Status: "+StatusIcon(run.Status, run.Conclusion)+" "+run.Status)
 			if run.Conclusion != "" {
 				content = append(content, "  Result: "+run.Conclusion)
@@ -156,10 +218,19 @@ func (a *App) buildInfoContent(maxWidth int) []string {
 			if !run.CreatedAt.IsZero() {
 				content = append(content, "  Created: "+run.CreatedAt.Format("2006-01-02 15:04:05"))
 			}
+			if elapsed, stuck, ok := a.cancelState(run, time.Now()); ok {
+				state := "requested " + formatCountdown(elapsed) + " ago, still running"
+				if stuck {
+					state += " - press C to force-cancel"
+				}
+				content = append(content, "  Cancel: "+state)
+			}
 			if run.URL != "" {
 				content = append(content, "")
 				content = append(content, "  URL: "+truncateString(run.URL, maxWidth-6))
 			}
+			state, known := a.runUsage[selected.ID]
+			content = append(content, a.buildUsageContent("Billable time", state, known)...)
 		} else {
 			content = append(content, "  Select a run")
 		}
@@ -195,7 +266,11 @@ func (a *App) buildLogsContent(maxWidth int) []string {
 
 	job, jobOk := a.jobs.Selected()
 	if jobOk {
-		content = append(content, "  Logs: "+job.Name)
+		title := "  Logs: " + job.Name
+		if n := a.logView.SelectedCount(); n > 0 {
+			title += " -- VISUAL LINE (" + strconv.Itoa(n) + ") --"
+		}
+		content = append(content, title)
 		content = append(content, "  "+strings.Repeat("─", 30))
 	}
 
@@ -356,28 +431,34 @@ func (a *App) renderStatusBar() string {
 	var actionHints string
 	switch a.focusedPane {
 	case WorkflowsPane:
-		actionHints = "[t]rigger [/]filter"
+		actionHints = "[t]rigger [e]nable/disable [H]ide-disabled [/]filter"
 	case RunsPane:
-		actionHints = "[c]ancel [r]erun [R]erun-failed [y]ank"
+		actionHints = "[c]ancel [r]erun [R]erun-failed [a]pprove [d]elete [w]atch [y]ank"
 	case JobsPane:
 		if a.detailTab == LogsTab && a.parsedLogs != nil && len(a.parsedLogs.Steps) > 0 {
 			if a.stepListFocused {
-				actionHints = "[↑/↓]step [Enter]logs [L]fullscreen"
+				actionHints = "[↑/↓]step [Enter]logs [L]fullscreen [p]ager [s]ave"
 			} else {
-				actionHints = "[↑/↓]scroll [Esc]steps [L]fullscreen"
+				actionHints = "[↑/↓]scroll [Esc]steps [L]fullscreen [p]ager [s]ave"
 			}
 		} else {
-			actionHints = "[L]fullscreen [y]ank"
+			actionHints = "[r]erun [b]debug-rerun [L]fullscreen [y]ank"
 		}
 	}
 
 	// Tab hints
-	tabHints := "[1]info [2]logs"
+	tabHints := "[1]info [2]logs [3]workflow [4]graph"
 
 	// Common hints
 	commonHints := "[?]help [q]uit"
 
 	hints := navHints + " " + actionHints + " " + tabHints + " " + commonHints
+	if watch := a.watchStatus(); watch != "" {
+		hints += " " + WatchStatusStyle.Render(watch)
+	}
+	if meter := renderRateLimitMeter(a.rateLimit, time.Now()); meter != "" {
+		hints += " " + meter
+	}
 
 	if a.filtering {
 		return StatusBar.Width(a.width).Render("Filter: " + a.filterInput.View())
@@ -387,6 +468,17 @@ func (a *App) renderStatusBar() string {
 		return StatusBar.Width(a.width).Render(a.flashMsg)
 	}
 
+	if a.offline {
+		return OfflineBanner.Width(a.width).Render(a.offlineStatus(time.Now()))
+	}
+
+	if retry := a.retryStatus(time.Now()); retry != "" {
+		return StatusBar.
+			Foreground(ColorYellow).
+			Width(a.width).
+			Render(retry)
+	}
+
 	if a.err != nil {
 		return StatusBar.
 			Foreground(lipgloss.Color("#FF0000")).
@@ -428,20 +520,59 @@ Shift+Tab   Previous pane
 Actions
 ──────────────────────────────────
 t           Trigger workflow
+e           Enable/disable workflow
+H           Hide disabled workflows
 c           Cancel run
+C           Force-cancel run stuck cancelling
 r           Rerun workflow
 R           Rerun failed jobs only
-y           Copy URL to clipboard
+b           Rerun with debug logging (run or job)
+a           Review pending deployments
+d           Delete run
+P           Purge run logs
+D           Delete all runs matching filter
+w           Watch run (notify when done)
+W           Watch all runs on my branch
+F           Flaky jobs of the workflow
+=           Mark run as compare base (Runs)
+=           Diff job logs against base (Jobs)
+y           Copy menu (URL, run ID, SHA, gh command, ...)
+yy          Copy URL to clipboard
+o           Open workflow file, run, job or step in browser
+O           Open the run's pull request in browser
+
+Multi-select (Runs)
+──────────────────────────────────
+Space       Mark/unmark run
+v           Visual range select
+Ctrl+A      Mark all filtered runs
+Esc         Clear marks
+c/r/R/d/P   Act on all marked runs
 
 Detail View
 ──────────────────────────────────
 1           Info tab
 2           Logs tab
+3           Workflow tab (YAML at the run's commit)
+4           Graph tab (job dependencies of the run)
+h/j/k/l     Move between jobs (Graph tab, Jobs)
+PgUp/PgDn   Scroll workflow tab
+[/]         Previous/next run attempt
+
+Jobs
+──────────────────────────────────
+r           Rerun job
+b           Rerun job with debug logging
+p           Open logs in $PAGER
+E           Open logs in $EDITOR
+s           Save logs to a file
 
 Step Navigation (Logs tab)
 ──────────────────────────────────
 ↓/↑         Select step
 Enter       Focus log content
+v           Select log lines (in log content)
+y           Copy selected log lines
 Esc         Back to step list
 
 View
@@ -2,7 +2,10 @@ package app
 
 import (
 	"errors"
+	"strings"
 	"testing"
+
+	"github.com/nnnkkk7/lazyactions/github"
 )
 
 func TestApp_RenderPanes(t *testing.T) {
@@ -58,3 +61,13 @@ func TestApp_RenderStatusBar_States(t *testing.T) {
 		t.Error("renderStatusBar with error returned empty string")
 	}
 }
+
+func TestApp_BuildInfoContent_DisabledWorkflow(t *testing.T) {
+	app := New()
+	app.setWorkflows([]github.Workflow{{ID: 1, Name: "Nightly", State: "disabled_inactivity"}})
+
+	content := strings.Join(app.buildInfoContent(80), "\n")
+	if !strings.Contains(content, "inactivity") {
+		t.Errorf("Info tab should explain why the workflow is disabled:\n%s", content)
+	}
+}
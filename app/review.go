@@ -0,0 +1,239 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Deployment review - approve or reject runs waiting on protected environments
+
+// ReviewCommentCharLimit is the maximum characters for a review comment
+const ReviewCommentCharLimit = 200
+
+// reviewDialog is the state of the deployment review dialog
+type reviewDialog struct {
+	runID          int64
+	runNumber      int
+	deployments    []github.PendingDeployment // Only those the user can approve
+	selected       []bool
+	cursor         int
+	comment        textinput.Model
+	commentFocused bool
+}
+
+// newReviewDialog creates a review dialog with every approvable environment selected
+func newReviewDialog(run github.Run, deployments []github.PendingDeployment) *reviewDialog {
+	var approvable []github.PendingDeployment
+	for _, d := range deployments {
+		if d.CanApprove {
+			approvable = append(approvable, d)
+		}
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "Comment (optional)"
+	ti.CharLimit = ReviewCommentCharLimit
+
+	selected := make([]bool, len(approvable))
+	for i := range selected {
+		selected[i] = true
+	}
+	return &reviewDialog{
+		runID:       run.ID,
+		runNumber:   run.RunNumber,
+		deployments: approvable,
+		selected:    selected,
+		comment:     ti,
+	}
+}
+
+// selectedDeployments returns the deployments checked in the dialog
+func (d *reviewDialog) selectedDeployments() []github.PendingDeployment {
+	var out []github.PendingDeployment
+	for i, dep := range d.deployments {
+		if d.selected[i] {
+			out = append(out, dep)
+		}
+	}
+	return out
+}
+
+// reviewDeployments starts a review of the selected run if it is waiting for approval
+func (a *App) reviewDeployments() tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok || !run.IsWaiting() {
+		return nil
+	}
+	a.reviewRunID = run.ID
+	ctx := newRequestContext(&a.cancelReview)
+	return fetchPendingDeployments(ctx, a.client, a.repo, run.ID)
+}
+
+// handlePendingDeployments caches the deployments of a waiting run and opens
+// the review dialog if the user asked for it
+func (a *App) handlePendingDeployments(msg PendingDeploymentsLoadedMsg) tea.Cmd {
+	if isCancelled(msg.Err) {
+		return nil
+	}
+	requested := msg.RunID == a.reviewRunID
+	if requested {
+		a.reviewRunID = 0
+	}
+	if msg.Err != nil {
+		if requested {
+			a.err = msg.Err
+		}
+		return nil
+	}
+	a.pendingDeployments[msg.RunID] = msg.Deployments
+
+	if !requested {
+		return nil
+	}
+	run, ok := a.runs.Selected()
+	if !ok || run.ID != msg.RunID {
+		return nil
+	}
+	review := newReviewDialog(run, msg.Deployments)
+	if len(review.deployments) == 0 {
+		return flashMessage("No environments you can approve", FlashDurationInfo)
+	}
+	a.review = review
+	return nil
+}
+
+// fetchPendingDeploymentsCmd fetches the deployments of waiting runs that are not
+// known yet, and forgets those of runs that are no longer waiting.
+// Requests for the previous list of runs are cancelled.
+func (a *App) fetchPendingDeploymentsCmd(runs []github.Run) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	ctx := newRequestContext(&a.cancelDeployments)
+	waiting := make(map[int64]bool)
+	var cmds []tea.Cmd
+	for _, run := range runs {
+		if !run.IsWaiting() {
+			continue
+		}
+		waiting[run.ID] = true
+		if _, ok := a.pendingDeployments[run.ID]; !ok {
+			cmds = append(cmds, fetchPendingDeployments(ctx, a.client, a.repo, run.ID))
+		}
+	}
+	for id := range a.pendingDeployments {
+		if !waiting[id] {
+			delete(a.pendingDeployments, id)
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleReviewInput handles input when in the review dialog
+func (a *App) handleReviewInput(msg tea.KeyMsg) tea.Cmd {
+	d := a.review
+
+	if d.commentFocused {
+		switch msg.String() {
+		case "tab", "enter", "esc":
+			d.commentFocused = false
+			d.comment.Blur()
+			return nil
+		}
+		var cmd tea.Cmd
+		d.comment, cmd = d.comment.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case "down", "j":
+		if d.cursor < len(d.deployments)-1 {
+			d.cursor++
+		}
+	case " ":
+		d.selected[d.cursor] = !d.selected[d.cursor]
+	case "tab":
+		d.commentFocused = true
+		return d.comment.Focus()
+	case "a", "x":
+		selected := d.selectedDeployments()
+		if len(selected) == 0 {
+			return flashMessage("Select an environment to review", FlashDurationInfo)
+		}
+		a.review = nil
+		return reviewDeployments(a.client, a.repo, d.runID, selected, msg.String() == "a", d.comment.Value())
+	case "esc", "q":
+		a.review = nil
+	}
+	return nil
+}
+
+// handleDeploymentsReviewed reports the outcome of a review
+func (a *App) handleDeploymentsReviewed(msg DeploymentsReviewedMsg) tea.Cmd {
+	if msg.Err != nil {
+		a.err = msg.Err
+		return nil
+	}
+	delete(a.pendingDeployments, msg.RunID)
+	verb := "Rejected"
+	if msg.Approved {
+		verb = "Approved"
+	}
+	a.flashMsg = verb + ": " + strings.Join(msg.Environments, ", ")
+	return a.refreshCurrentWorkflow()
+}
+
+// waitingBadge describes what a waiting run is waiting for
+// (e.g., "waiting for approval: production")
+func (a *App) waitingBadge(run github.Run) string {
+	deployments := a.pendingDeployments[run.ID]
+	if len(deployments) == 0 {
+		return "waiting for approval"
+	}
+	names := make([]string, len(deployments))
+	for i, d := range deployments {
+		names[i] = d.Environment
+	}
+	return "waiting for approval: " + strings.Join(names, ", ")
+}
+
+// renderReviewDialog renders the deployment review dialog
+func (a *App) renderReviewDialog() string {
+	d := a.review
+
+	lines := []string{
+		lipgloss.NewStyle().Bold(true).Render("Review deployments for #" + strconv.Itoa(d.runNumber)),
+		"",
+	}
+	for i, dep := range d.deployments {
+		cursor := "  "
+		if i == d.cursor && !d.commentFocused {
+			cursor = "> "
+		}
+		check := "[ ]"
+		if d.selected[i] {
+			check = "[x]"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s", cursor, check, dep.Environment))
+	}
+	lines = append(lines,
+		"",
+		d.comment.View(),
+		"",
+		"[space]select [tab]comment [a]pprove [x]reject [esc]close",
+	)
+
+	dialog := ReviewDialog.Width(60).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height,
+		lipgloss.Center, lipgloss.Center, dialog)
+}
@@ -0,0 +1,191 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newReviewApp(state *mockClientState) (*App, *github.MockClient) {
+	mock := newMockClient(state)
+	app := New(WithClient(mock), WithRepository(github.Repository{Owner: "owner", Name: "repo"}))
+	app.width = 100
+	app.height = 40
+	app.focusedPane = RunsPane
+	app.runs.SetItems([]github.Run{{ID: 7, RunNumber: 3, Status: "waiting"}})
+	return app, mock
+}
+
+func TestApp_ReviewDeployments_OpensDialog(t *testing.T) {
+	app, _ := newReviewApp(&mockClientState{
+		deployments: []github.PendingDeployment{
+			{EnvironmentID: 1, Environment: "production", CanApprove: true},
+			{EnvironmentID: 2, Environment: "legal", CanApprove: false},
+		},
+	})
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if cmd == nil {
+		t.Fatal("expected a fetch command")
+	}
+	app.Update(cmd())
+
+	if app.review == nil {
+		t.Fatal("review dialog should be open")
+	}
+	if len(app.review.deployments) != 1 || app.review.deployments[0].Environment != "production" {
+		t.Errorf("dialog deployments = %+v, want only approvable ones", app.review.deployments)
+	}
+	if view := app.View(); !strings.Contains(view, "production") || strings.Contains(view, "legal") {
+		t.Errorf("dialog should list only approvable environments:\n%s", view)
+	}
+	if got := app.waitingBadge(github.Run{ID: 7}); got != "waiting for approval: production, legal" {
+		t.Errorf("waitingBadge() = %q", got)
+	}
+}
+
+func TestApp_ReviewDeployments_NothingToApprove(t *testing.T) {
+	app, _ := newReviewApp(&mockClientState{
+		deployments: []github.PendingDeployment{{EnvironmentID: 1, Environment: "production"}},
+	})
+
+	cmd := app.reviewDeployments()
+	_, cmd = app.Update(cmd())
+
+	if app.review != nil {
+		t.Error("review dialog should not open without approvable environments")
+	}
+	msgs := runCmds(cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages, want a flash message", len(msgs))
+	}
+	if msg, ok := msgs[0].(FlashMsg); !ok || !strings.Contains(msg.Message, "No environments") {
+		t.Errorf("flash = %v", msgs[0])
+	}
+}
+
+func TestApp_ReviewDeployments_IgnoresRunsNotWaiting(t *testing.T) {
+	app, _ := newReviewApp(nil)
+	app.runs.SetItems([]github.Run{{ID: 7, Status: "in_progress"}})
+
+	if cmd := app.reviewDeployments(); cmd != nil {
+		t.Error("reviewDeployments() should do nothing for runs that are not waiting")
+	}
+}
+
+func TestApp_ReviewDeployments_Cancelled(t *testing.T) {
+	app, mock := newReviewApp(nil)
+	mock.ListPendingDeploymentsFunc = func(ctx context.Context, repo github.Repository, runID int64) ([]github.PendingDeployment, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return []github.PendingDeployment{{EnvironmentID: 1, Environment: "production", CanApprove: true}}, nil
+	}
+
+	first := app.reviewDeployments()
+	second := app.reviewDeployments()
+
+	// The superseded request is cancelled and does not end the review
+	app.Update(first())
+	if app.err != nil || app.review != nil {
+		t.Errorf("err = %v, review = %v, want the cancelled request ignored", app.err, app.review)
+	}
+	app.Update(second())
+	if app.review == nil {
+		t.Error("review dialog should open for the latest request")
+	}
+}
+
+func TestApp_ReviewInput(t *testing.T) {
+	app, mock := newReviewApp(nil)
+	app.review = newReviewDialog(github.Run{ID: 7, RunNumber: 3}, []github.PendingDeployment{
+		{EnvironmentID: 1, Environment: "staging", CanApprove: true},
+		{EnvironmentID: 2, Environment: "production", CanApprove: true},
+	})
+
+	// Deselect staging, then type a comment
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ship it")})
+	if !app.review.commentFocused {
+		t.Fatal("comment should be focused")
+	}
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if app.review != nil {
+		t.Error("dialog should close after approving")
+	}
+	if cmd == nil {
+		t.Fatal("expected a review command")
+	}
+	msg, ok := cmd().(DeploymentsReviewedMsg)
+	if !ok || !msg.Approved {
+		t.Fatalf("cmd() = %v, want approved DeploymentsReviewedMsg", msg)
+	}
+
+	calls := mock.ReviewPendingDeploymentsCalls()
+	if len(calls) != 1 {
+		t.Fatalf("ReviewPendingDeployments called %d times, want 1", len(calls))
+	}
+	if c := calls[0]; c.RunID != 7 || len(c.EnvironmentIDs) != 1 || c.EnvironmentIDs[0] != 2 || c.Comment != "ship it" {
+		t.Errorf("ReviewPendingDeployments(%d, %v, %q)", c.RunID, c.EnvironmentIDs, c.Comment)
+	}
+
+	app.Update(msg)
+	if app.flashMsg != "Approved: production" {
+		t.Errorf("flashMsg = %q", app.flashMsg)
+	}
+}
+
+func TestApp_ReviewInput_RequiresSelection(t *testing.T) {
+	app, mock := newReviewApp(nil)
+	app.review = newReviewDialog(github.Run{ID: 7}, []github.PendingDeployment{
+		{EnvironmentID: 1, Environment: "production", CanApprove: true},
+	})
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}); cmd == nil {
+		t.Error("expected a flash message")
+	}
+	if app.review == nil {
+		t.Error("dialog should stay open without a selection")
+	}
+	if len(mock.ReviewPendingDeploymentsCalls()) != 0 {
+		t.Error("nothing should be reviewed without a selection")
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.review != nil {
+		t.Error("Esc should close the dialog")
+	}
+}
+
+func TestApp_FetchPendingDeploymentsCmd(t *testing.T) {
+	app, _ := newReviewApp(nil)
+	app.pendingDeployments[1] = []github.PendingDeployment{{Environment: "old"}}
+	app.pendingDeployments[2] = []github.PendingDeployment{{Environment: "known"}}
+
+	cmd := app.fetchPendingDeploymentsCmd([]github.Run{
+		{ID: 1, Status: "completed"},
+		{ID: 2, Status: "waiting"},
+		{ID: 3, Status: "waiting"},
+	})
+
+	if _, ok := app.pendingDeployments[1]; ok {
+		t.Error("deployments of runs no longer waiting should be forgotten")
+	}
+	if _, ok := app.pendingDeployments[2]; !ok {
+		t.Error("deployments of waiting runs should be kept")
+	}
+	msgs := runCmds(cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages, want 1", len(msgs))
+	}
+	if msg, ok := msgs[0].(PendingDeploymentsLoadedMsg); !ok || msg.RunID != 3 {
+		t.Errorf("msg = %v, want deployments of run 3", msgs[0])
+	}
+}
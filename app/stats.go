@@ -0,0 +1,330 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Workflow health - statistics of the last runs of a workflow,
+// shown in the Info tab of the workflows pane
+
+const (
+	// DefaultStatsRuns is how many recent runs the statistics are computed from
+	DefaultStatsRuns = 100
+	// MaxStatsRuns caps the runs fetched for statistics
+	MaxStatsRuns = 1000
+	// StatsPageSize is the number of runs fetched per request
+	StatsPageSize = 100
+	// StatsConcurrency limits the pages fetched at once
+	StatsConcurrency = 3
+	// StatsCacheTTL is how long statistics are reused before being fetched again
+	StatsCacheTTL = 5 * time.Minute
+)
+
+// sparkBlocks are the bars of a sparkline, from lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// workflowStats are statistics of the recent runs of a workflow
+type workflowStats struct {
+	Runs        int // Completed runs the statistics are computed from
+	Succeeded   int
+	Failed      int
+	Median      time.Duration
+	P95         time.Duration
+	Durations   []time.Duration // Oldest first
+	Conclusions []string        // Oldest first
+	MTTR        time.Duration   // Mean time to recovery on the default branch
+	Recoveries  int
+}
+
+// statsState is the statistics of a workflow, once loaded
+type statsState struct {
+	stats     *workflowStats
+	err       error
+	loading   bool
+	fetchedAt time.Time
+}
+
+// isFailure returns true for conclusions that count as failures
+func isFailure(conclusion string) bool {
+	switch conclusion {
+	case "failure", "timed_out", "startup_failure":
+		return true
+	}
+	return false
+}
+
+// computeStats computes statistics from runs in any order.
+// Runs that have not completed are ignored.
+func computeStats(runs []github.Run, defaultBranch string) workflowStats {
+	var completed []github.Run
+	for _, run := range runs {
+		if run.Status == "completed" {
+			completed = append(completed, run)
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].CreatedAt.Before(completed[j].CreatedAt)
+	})
+
+	stats := workflowStats{Runs: len(completed)}
+	var sorted []time.Duration
+	for _, run := range completed {
+		switch {
+		case run.Conclusion == "success":
+			stats.Succeeded++
+		case isFailure(run.Conclusion):
+			stats.Failed++
+		}
+		stats.Conclusions = append(stats.Conclusions, run.Conclusion)
+		if d := run.Duration(run.UpdatedAt); d > 0 {
+			stats.Durations = append(stats.Durations, d)
+			sorted = append(sorted, d)
+		}
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	stats.Median = percentile(sorted, 50)
+	stats.P95 = percentile(sorted, 95)
+	stats.MTTR, stats.Recoveries = meanTimeToRecovery(completed, defaultBranch)
+	return stats
+}
+
+// percentile returns the p-th percentile of sorted durations (nearest rank)
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// meanTimeToRecovery returns the mean time from the first failed run on branch
+// to the next successful one, and the number of such recoveries.
+// runs must be sorted oldest first.
+func meanTimeToRecovery(runs []github.Run, branch string) (time.Duration, int) {
+	if branch == "" {
+		return 0, 0
+	}
+	var total time.Duration
+	var recoveries int
+	var failedAt time.Time
+	for _, run := range runs {
+		if run.Branch != branch {
+			continue
+		}
+		switch {
+		case isFailure(run.Conclusion) && failedAt.IsZero():
+			failedAt = run.UpdatedAt
+		case run.Conclusion == "success" && !failedAt.IsZero():
+			if run.UpdatedAt.After(failedAt) {
+				total += run.UpdatedAt.Sub(failedAt)
+				recoveries++
+			}
+			failedAt = time.Time{}
+		}
+	}
+	if recoveries == 0 {
+		return 0, 0
+	}
+	return total / time.Duration(recoveries), recoveries
+}
+
+// fetchStatsCmd fetches the statistics of the selected workflow
+// unless they were fetched within StatsCacheTTL, cancelling the request
+// of the previously selected one. Nothing is fetched unless the Info tab or the
+// flaky jobs list, which is detected from the same runs, is shown.
+func (a *App) fetchStatsCmd() tea.Cmd {
+	if a.client == nil || (a.detailTab != InfoTab && a.flakyList == nil) {
+		return nil
+	}
+	wf, ok := a.workflows.Selected()
+	if !ok {
+		return nil
+	}
+	if state, known := a.workflowStats[wf.ID]; known && (state.loading || time.Since(state.fetchedAt) < StatsCacheTTL) {
+		return nil
+	}
+	a.workflowStats[wf.ID] = statsState{loading: true}
+	ctx := newRequestContext(&a.cancelStats)
+	return fetchWorkflowStats(ctx, a.client, a.repo, wf.ID, a.statsRuns, a.defaultBranch)
+}
+
+// fetchWorkflowStats creates a command to fetch the last n runs of a workflow,
+// StatsConcurrency pages at a time, and the default branch if not known yet.
+// It captures the client, repo, and workflowID to avoid race conditions.
+func fetchWorkflowStats(ctx context.Context, client github.Client, repo github.Repository, workflowID int64, n int, defaultBranch string) tea.Cmd {
+	return func() tea.Msg {
+		pages := (n + StatsPageSize - 1) / StatsPageSize
+		results := make([][]github.Run, pages)
+		errs := make([]error, pages+1)
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, StatsConcurrency)
+		for page := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				results[page], errs[page] = client.ListRuns(ctx, repo, &github.ListRunsOpts{
+					WorkflowID: workflowID,
+					PerPage:    StatsPageSize,
+					Page:       page + 1,
+				})
+			}()
+		}
+		if defaultBranch == "" {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defaultBranch, errs[pages] = client.GetDefaultBranch(ctx, repo)
+			}()
+		}
+		wg.Wait()
+
+		var runs []github.Run
+		for _, r := range results {
+			runs = append(runs, r...)
+		}
+		if len(runs) > n {
+			runs = runs[:n]
+		}
+		for _, err := range errs {
+			if err != nil {
+				return WorkflowStatsLoadedMsg{WorkflowID: workflowID, Err: err}
+			}
+		}
+		return WorkflowStatsLoadedMsg{
+			WorkflowID:    workflowID,
+			Runs:          runs,
+			DefaultBranch: defaultBranch,
+		}
+	}
+}
+
+// handleWorkflowStatsLoaded computes and caches the statistics of a workflow,
+// and detects its flaky jobs from the same runs.
+// Cancelled statistics are forgotten, and fetched again if the workflow
+// is selected again meanwhile.
+func (a *App) handleWorkflowStatsLoaded(msg WorkflowStatsLoadedMsg) tea.Cmd {
+	if isCancelled(msg.Err) {
+		delete(a.workflowStats, msg.WorkflowID)
+		return a.fetchStatsCmd()
+	}
+	if msg.Err != nil {
+		a.workflowStats[msg.WorkflowID] = statsState{err: msg.Err}
+		a.flaky[msg.WorkflowID] = flakyState{err: msg.Err}
+		return nil
+	}
+	if msg.DefaultBranch != "" {
+		a.defaultBranch = msg.DefaultBranch
+	}
+	stats := computeStats(msg.Runs, a.defaultBranch)
+	a.workflowStats[msg.WorkflowID] = statsState{stats: &stats, fetchedAt: time.Now()}
+	return a.detectFlakyCmd(msg.WorkflowID, msg.Runs)
+}
+
+// buildStatsContent builds the health statistics of the Info tab
+func (a *App) buildStatsContent(workflowID int64, maxWidth int) []string {
+	content := []string{"", fmt.Sprintf("  Health (last %d runs)", a.statsRuns)}
+	state, known := a.workflowStats[workflowID]
+	switch {
+	case !known || state.loading:
+		return append(content, "    Loading...")
+	case state.err != nil:
+		return append(content, "    Unavailable: "+state.err.Error())
+	case state.stats.Runs == 0:
+		return append(content, "    No completed runs")
+	}
+
+	s := state.stats
+	content = append(content,
+		fmt.Sprintf("    Success:  %s  Failure: %s (%d runs)",
+			formatPercent(s.Succeeded, s.Runs), formatPercent(s.Failed, s.Runs), s.Runs))
+	if len(s.Durations) > 0 {
+		content = append(content,
+			"    Duration: median "+formatCountdown(s.Median)+", p95 "+formatCountdown(s.P95),
+			"    Trend:    "+sparkline(lastN(s.Durations, maxWidth-16)))
+	}
+	content = append(content, "    Recent:   "+conclusionStrip(lastN(s.Conclusions, maxWidth-16)))
+	if a.defaultBranch != "" {
+		mttr := "no recoveries"
+		if s.Recoveries > 0 {
+			mttr = fmt.Sprintf("%s (%d recoveries)", formatCountdown(s.MTTR), s.Recoveries)
+			if s.Recoveries == 1 {
+				mttr = formatCountdown(s.MTTR) + " (1 recovery)"
+			}
+		}
+		content = append(content, "    MTTR on "+a.defaultBranch+": "+mttr)
+	}
+	return content
+}
+
+// lastN returns the last n items of s
+func lastN[T any](s []T, n int) []T {
+	if n < 0 {
+		n = 0
+	}
+	if len(s) > n {
+		return s[len(s)-n:]
+	}
+	return s
+}
+
+// formatPercent formats n of total as a percentage (e.g., "92%")
+func formatPercent(n, total int) string {
+	if total == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%d%%", (n*100+total/2)/total)
+}
+
+// sparkline renders durations as bars scaled between the shortest and longest
+func sparkline(durations []time.Duration) string {
+	if len(durations) == 0 {
+		return ""
+	}
+	lo, hi := durations[0], durations[0]
+	for _, d := range durations {
+		lo = min(lo, d)
+		hi = max(hi, d)
+	}
+	var b strings.Builder
+	for _, d := range durations {
+		i := 0
+		if hi > lo {
+			i = int((d - lo) * time.Duration(len(sparkBlocks)-1) / (hi - lo))
+		}
+		b.WriteRune(sparkBlocks[i])
+	}
+	return b.String()
+}
+
+// conclusionStrip renders conclusions as colored blocks
+func conclusionStrip(conclusions []string) string {
+	var b strings.Builder
+	for _, c := range conclusions {
+		switch {
+		case c == "success":
+			b.WriteString(SuccessStyle.Render("■"))
+		case isFailure(c):
+			b.WriteString(FailureStyle.Render("■"))
+		case c == "cancelled":
+			b.WriteString(CancelledStyle.Render("■"))
+		default:
+			b.WriteString(QueuedStyle.Render("■"))
+		}
+	}
+	return b.String()
+}
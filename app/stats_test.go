@@ -0,0 +1,214 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// statsRun returns a completed run created at minute i that took d
+func statsRun(i int, branch, conclusion string, d time.Duration) github.Run {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Hour)
+	return github.Run{
+		ID:         int64(i),
+		Status:     "completed",
+		Conclusion: conclusion,
+		Branch:     branch,
+		CreatedAt:  created,
+		UpdatedAt:  created.Add(d),
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	runs := []github.Run{
+		// Newest first, as returned by the API
+		statsRun(6, "main", "success", 5*time.Minute),
+		statsRun(5, "feature", "success", 3*time.Minute),
+		statsRun(4, "main", "failure", 2*time.Minute),
+		statsRun(3, "main", "success", 4*time.Minute),
+		statsRun(2, "main", "failure", time.Minute),
+		statsRun(1, "main", "cancelled", 10*time.Minute),
+		{ID: 7, Status: "in_progress"},
+	}
+
+	s := computeStats(runs, "main")
+
+	if s.Runs != 6 || s.Succeeded != 3 || s.Failed != 2 {
+		t.Errorf("Runs/Succeeded/Failed = %d/%d/%d, want 6/3/2", s.Runs, s.Succeeded, s.Failed)
+	}
+	if s.Median != 3*time.Minute || s.P95 != 10*time.Minute {
+		t.Errorf("Median/P95 = %v/%v, want 3m/10m", s.Median, s.P95)
+	}
+	if s.Conclusions[0] != "cancelled" || s.Conclusions[5] != "success" {
+		t.Errorf("Conclusions = %v, want oldest first", s.Conclusions)
+	}
+	// main failed at 2h01m and recovered at 3h04m; failed at 4h02m and recovered at 6h05m
+	wantMTTR := ((time.Hour + 3*time.Minute) + (2*time.Hour + 3*time.Minute)) / 2
+	if s.Recoveries != 2 || s.MTTR != wantMTTR {
+		t.Errorf("MTTR = %v over %d recoveries, want %v over 2", s.MTTR, s.Recoveries, wantMTTR)
+	}
+}
+
+func TestComputeStats_NoDefaultBranch(t *testing.T) {
+	s := computeStats([]github.Run{
+		statsRun(1, "main", "failure", time.Minute),
+		statsRun(2, "main", "success", time.Minute),
+	}, "")
+	if s.Recoveries != 0 {
+		t.Error("MTTR needs the default branch")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		p    int
+		want time.Duration
+	}{
+		{50, 5},
+		{95, 10},
+		{1, 1},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); got != tt.want {
+			t.Errorf("percentile(%d) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile(nil) = %v, want 0", got)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	got := sparkline([]time.Duration{time.Minute, 8 * time.Minute, 4 * time.Minute})
+	if got != "▁█▄" {
+		t.Errorf("sparkline() = %q, want ▁█▄", got)
+	}
+	if got := sparkline([]time.Duration{time.Minute, time.Minute}); got != "▁▁" {
+		t.Errorf("sparkline() of equal durations = %q", got)
+	}
+}
+
+func TestFetchWorkflowStats_BoundedConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	mock := newMockClient(&mockClientState{defaultBranch: "main"})
+	mock.ListRunsFunc = func(_ context.Context, _ github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		runs := make([]github.Run, opts.PerPage)
+		for i := range runs {
+			runs[i] = statsRun((opts.Page-1)*opts.PerPage+i, "main", "success", time.Minute)
+		}
+		return runs, nil
+	}
+
+	msg := fetchWorkflowStats(context.Background(), mock, github.Repository{}, 1, 450, "")().(WorkflowStatsLoadedMsg)
+
+	if msg.Err != nil {
+		t.Fatalf("Err = %v", msg.Err)
+	}
+	if len(mock.ListRunsCalls()) != 5 {
+		t.Errorf("ListRuns called %d times, want 5 pages", len(mock.ListRunsCalls()))
+	}
+	if got := maxInFlight.Load(); got > StatsConcurrency {
+		t.Errorf("%d pages fetched at once, want at most %d", got, StatsConcurrency)
+	}
+	if len(msg.Runs) != 450 || msg.DefaultBranch != "main" {
+		t.Errorf("got %d runs on %q, want 450 on main", len(msg.Runs), msg.DefaultBranch)
+	}
+}
+
+func TestApp_WorkflowStats(t *testing.T) {
+	mock := newMockClient(&mockClientState{
+		defaultBranch: "main",
+		runs: []github.Run{
+			statsRun(3, "main", "success", 4*time.Minute),
+			statsRun(2, "main", "failure", 2*time.Minute),
+			statsRun(1, "main", "success", 2*time.Minute),
+		},
+	})
+	app := New(WithClient(mock), WithStatsRuns(50))
+	app.width = 120
+	app.height = 40
+	app.detailTab = InfoTab
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+
+	for _, msg := range runCmds(app.fetchStatsCmd()) {
+		app.Update(msg)
+	}
+	if calls := mock.ListRunsCalls(); len(calls) != 1 || calls[0].Opts.PerPage != StatsPageSize {
+		t.Errorf("ListRuns calls = %v", calls)
+	}
+
+	view := app.View()
+	for _, want := range []string{"Health (last 50 runs)", "Success:  67%", "median 2m, p95 4m", "MTTR on main: 1h2m (1 recovery)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should contain %q:\n%s", want, view)
+		}
+	}
+
+	// Cached
+	if cmd := app.fetchStatsCmd(); cmd != nil {
+		t.Error("statistics should be cached")
+	}
+	app.workflowStats[1] = statsState{stats: &workflowStats{}, fetchedAt: time.Now().Add(-StatsCacheTTL)}
+	if cmd := app.fetchStatsCmd(); cmd == nil {
+		t.Error("expired statistics should be fetched again")
+	}
+}
+
+func TestApp_WorkflowStats_CancelledOnWorkflowChange(t *testing.T) {
+	mock := newMockClient(&mockClientState{defaultBranch: "main"})
+	mock.ListRunsFunc = func(ctx context.Context, _ github.Repository, _ *github.ListRunsOpts) ([]github.Run, error) {
+		return nil, ctx.Err()
+	}
+	app := New(WithClient(mock))
+	app.detailTab = InfoTab
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}, {ID: 2, Name: "Deploy"}})
+
+	stats := app.fetchStatsCmd()
+	app.workflows.SelectNext()
+	app.onWorkflowSelectionChange()
+
+	msg := stats().(WorkflowStatsLoadedMsg)
+	if !isCancelled(msg.Err) {
+		t.Fatalf("err = %v, want the request cancelled", msg.Err)
+	}
+	app.Update(msg)
+	if _, ok := app.workflowStats[1]; ok {
+		t.Error("cancelled statistics should be forgotten")
+	}
+	if state := app.workflowStats[2]; !state.loading {
+		t.Error("statistics of the selected workflow should be loading")
+	}
+}
+
+func TestApp_WorkflowStats_OnlyInInfoTab(t *testing.T) {
+	mock := newMockClient(&mockClientState{defaultBranch: "main"})
+	app := New(WithClient(mock))
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+
+	if cmd := app.fetchStatsCmd(); cmd != nil {
+		t.Error("statistics should only be fetched for the Info tab")
+	}
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+	if state := app.workflowStats[1]; state.stats == nil {
+		t.Errorf("stats = %+v, want them fetched once the Info tab is shown", state)
+	}
+}
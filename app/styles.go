@@ -65,7 +65,9 @@ var (
 	FailureStyle   = lipgloss.NewStyle().Foreground(ColorRed)
 	RunningStyle   = lipgloss.NewStyle().Foreground(ColorYellow)
 	QueuedStyle    = lipgloss.NewStyle().Foreground(ColorLightGray)
+	WaitingStyle   = lipgloss.NewStyle().Foreground(ColorCyan)
 	CancelledStyle = lipgloss.NewStyle().Foreground(ColorOrange)
+	FlakyStyle     = lipgloss.NewStyle().Foreground(ColorOrange)
 )
 
 // Selection styles - lazydocker style: bright selection for focused, dim for unfocused
@@ -87,6 +89,16 @@ var (
 	NormalItem = lipgloss.NewStyle().
 			Foreground(ColorSilver)
 
+	// Marker for items marked for bulk actions
+	MarkStyle = lipgloss.NewStyle().
+			Foreground(ColorOrange).
+			Bold(true)
+
+	// Disabled workflows are dimmed
+	DisabledItem = lipgloss.NewStyle().
+			Foreground(ColorMediumGray).
+			Faint(true)
+
 	// Keep backward compatibility
 	SelectedItem = SelectedItemFocused
 )
@@ -98,6 +110,21 @@ var (
 			BorderForeground(ColorOrange).
 			Padding(1, 2)
 
+	DeleteDialog = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorRed).
+			Padding(1, 2)
+
+	ReviewDialog = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorCyan).
+			Padding(1, 2)
+
+	FlakyDialog = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorOrange).
+			Padding(1, 2)
+
 	HelpPopup = lipgloss.NewStyle().
 			Border(lipgloss.DoubleBorder()).
 			BorderForeground(ColorCyan).
@@ -106,6 +133,19 @@ var (
 	StatusBar = lipgloss.NewStyle().
 			Background(ColorDarkGray).
 			Padding(0, 1)
+
+	OfflineBanner = lipgloss.NewStyle().
+			Background(ColorOrange).
+			Foreground(ColorBlack).
+			Bold(true).
+			Padding(0, 1)
+)
+
+// Rate limit meter styles - share the status bar background
+var (
+	RateLimitOKStyle       = lipgloss.NewStyle().Foreground(ColorSilver).Background(ColorDarkGray)
+	RateLimitLowStyle      = lipgloss.NewStyle().Foreground(ColorYellow).Background(ColorDarkGray)
+	RateLimitCriticalStyle = lipgloss.NewStyle().Foreground(ColorRed).Background(ColorDarkGray).Bold(true)
 )
 
 // Log syntax highlighting styles
@@ -128,6 +168,8 @@ func StatusIcon(status, conclusion string) string {
 		return RunningStyle.Render("●")
 	case status == "queued":
 		return QueuedStyle.Render("○")
+	case status == "waiting":
+		return WaitingStyle.Render("◷")
 	case conclusion == "success":
 		return SuccessStyle.Render("✓")
 	case conclusion == "failure":
@@ -154,3 +196,26 @@ func ScrollPosition(current, total int) string {
 	}
 	return fmt.Sprintf("%d/%d", current+1, total)
 }
+
+// Watch styles - marker in the runs pane and indicator in the status bar
+var (
+	WatchStyle       = lipgloss.NewStyle().Foreground(ColorCyan)
+	WatchStatusStyle = lipgloss.NewStyle().Foreground(ColorCyan).Background(ColorDarkGray)
+)
+
+// Log diff styles - lines only in one of the compared runs, and the first
+// diverging line of each step
+var (
+	DiffAddedStyle   = lipgloss.NewStyle().Foreground(ColorGreen)
+	DiffRemovedStyle = lipgloss.NewStyle().Foreground(ColorRed)
+	DiffFirstStyle   = lipgloss.NewStyle().Foreground(ColorBlack).Background(ColorYellow).Bold(true)
+	DiffStepStyle    = lipgloss.NewStyle().Foreground(ColorCyan).Bold(true)
+	CompareBaseStyle = lipgloss.NewStyle().Foreground(ColorCyan).Bold(true)
+)
+
+// Workflow YAML syntax highlighting styles
+var (
+	YAMLKeyStyle     = lipgloss.NewStyle().Foreground(ColorCyan)
+	YAMLExprStyle    = lipgloss.NewStyle().Foreground(ColorOrange)
+	YAMLCommentStyle = lipgloss.NewStyle().Foreground(ColorLightGray)
+)
@@ -83,7 +83,7 @@ func TestStatusIcon_AllStatuses(t *testing.T) {
 		{"completed_cancelled", "completed", "cancelled", "⊘"},
 		{"empty_empty", "", "", " "},
 		{"completed_skipped", "completed", "skipped", " "},
-		{"waiting", "waiting", "", " "},
+		{"waiting", "waiting", "", "◷"},
 	}
 
 	for _, tt := range tests {
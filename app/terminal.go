@@ -0,0 +1,50 @@
+package app
+
+import (
+	"bytes"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TerminalWriter passes raw output, such as terminal notification escape
+// sequences, to the running program. Update writes it to the terminal the
+// program renders to (/dev/tty when stdout is not a terminal), so commands
+// never write to the terminal themselves. Output is dropped while no
+// program runs.
+type TerminalWriter struct {
+	mu      sync.Mutex
+	program *tea.Program
+}
+
+// NewTerminalWriter returns a TerminalWriter; pass it to WithTerminalWriter
+// to connect it to the program.
+func NewTerminalWriter() *TerminalWriter {
+	return &TerminalWriter{}
+}
+
+// Write implements io.Writer.
+func (w *TerminalWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	program := w.program
+	w.mu.Unlock()
+
+	if program != nil {
+		program.Send(TerminalOutputMsg{Data: bytes.Clone(p)})
+	}
+	return len(p), nil
+}
+
+// attach connects the writer to program; nil disconnects it.
+func (w *TerminalWriter) attach(program *tea.Program) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.program = program
+}
+
+// handleTerminalOutput writes raw output to the program's terminal
+func (a *App) handleTerminalOutput(msg TerminalOutputMsg) {
+	if a.output != nil {
+		_, _ = a.output.Write(msg.Data)
+	}
+}
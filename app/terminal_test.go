@@ -0,0 +1,59 @@
+package app
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/notify"
+)
+
+// outputModel quits once it receives terminal output
+type outputModel struct {
+	got []byte
+}
+
+func (m *outputModel) Init() tea.Cmd { return nil }
+
+func (m *outputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(TerminalOutputMsg); ok {
+		m.got = msg.Data
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m *outputModel) View() string { return "" }
+
+func TestTerminalWriter_SendsToProgram(t *testing.T) {
+	w := NewTerminalWriter()
+	if n, err := w.Write([]byte("\a")); n != 1 || err != nil {
+		t.Errorf("Write() without a program = %d, %v, want the output dropped", n, err)
+	}
+
+	model := &outputModel{}
+	p := tea.NewProgram(model, tea.WithInput(nil), tea.WithOutput(io.Discard))
+	w.attach(p)
+	go func() {
+		bell, _ := notify.New("bell", w)
+		_ = bell.Notify(notify.Notification{Title: "CI"})
+	}()
+	if _, err := p.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if string(model.got) != "\a" {
+		t.Errorf("program got %q, want the bell", model.got)
+	}
+}
+
+func TestApp_TerminalOutput(t *testing.T) {
+	var out bytes.Buffer
+	app := New()
+	app.output = &out
+
+	app.Update(TerminalOutputMsg{Data: []byte("\x1b]9;CI: done\x07")})
+	if out.String() != "\x1b]9;CI: done\x07" {
+		t.Errorf("output = %q, want the escape sequence", out.String())
+	}
+}
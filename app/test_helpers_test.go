@@ -10,6 +10,20 @@ import (
 // errAPI is a test error used to simulate API errors
 var errAPI = errors.New("API error")
 
+// mockClipboard records the text written to the clipboard
+type mockClipboard struct {
+	content string
+	err     error
+}
+
+func (m *mockClipboard) WriteAll(text string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.content = text
+	return nil
+}
+
 type mockClientState struct {
 	workflows []github.Workflow
 	runs      []github.Run
@@ -17,6 +31,15 @@ type mockClientState struct {
 	logs      string
 	err       error
 	rateLimit int
+
+	deployments   []github.PendingDeployment
+	usage         *github.Usage
+	defaultBranch string
+	files         map[string]string // Content by "path@ref"
+
+	// Earlier run attempts, by attempt number
+	attempts    map[int]github.Run
+	attemptJobs map[int][]github.Job
 }
 
 func newMockClient(state *mockClientState) *github.MockClient {
@@ -25,35 +48,101 @@ func newMockClient(state *mockClientState) *github.MockClient {
 	}
 
 	return &github.MockClient{
+		GetDefaultBranchFunc: func(ctx context.Context, repo github.Repository) (string, error) {
+			return state.defaultBranch, state.err
+		},
+		GetFileContentFunc: func(ctx context.Context, repo github.Repository, path, ref string) (string, error) {
+			return state.files[path+"@"+ref], state.err
+		},
 		ListWorkflowsFunc: func(ctx context.Context, repo github.Repository) ([]github.Workflow, error) {
 			return state.workflows, state.err
 		},
+		EnableWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowID int64) error {
+			return state.err
+		},
+		DisableWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowID int64) error {
+			return state.err
+		},
+		GetWorkflowUsageFunc: func(ctx context.Context, repo github.Repository, workflowID int64) (*github.Usage, error) {
+			return state.usage, state.err
+		},
 		ListRunsFunc: func(ctx context.Context, repo github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
 			return state.runs, state.err
 		},
+		GetRunFunc: func(ctx context.Context, repo github.Repository, runID int64) (*github.Run, error) {
+			if state.err != nil {
+				return nil, state.err
+			}
+			for _, r := range state.runs {
+				if r.ID == runID {
+					return &r, nil
+				}
+			}
+			return nil, &github.AppError{Type: github.ErrTypeNotFound, Message: "Resource not found"}
+		},
+		GetRunUsageFunc: func(ctx context.Context, repo github.Repository, runID int64) (*github.Usage, error) {
+			return state.usage, state.err
+		},
+		GetRunAttemptFunc: func(ctx context.Context, repo github.Repository, runID int64, attempt int) (*github.Run, error) {
+			if state.err != nil {
+				return nil, state.err
+			}
+			if r, ok := state.attempts[attempt]; ok {
+				return &r, nil
+			}
+			return nil, &github.AppError{Type: github.ErrTypeNotFound, Message: "Resource not found"}
+		},
 		ListJobsFunc: func(ctx context.Context, repo github.Repository, runID int64) ([]github.Job, error) {
 			return state.jobs, state.err
 		},
+		ListJobsForAttemptFunc: func(ctx context.Context, repo github.Repository, runID int64, attempt int) ([]github.Job, error) {
+			return state.attemptJobs[attempt], state.err
+		},
+		RerunJobFunc: func(ctx context.Context, repo github.Repository, jobID int64, opts *github.RerunOpts) error {
+			return state.err
+		},
 		GetJobLogsFunc: func(ctx context.Context, repo github.Repository, jobID int64) (string, error) {
 			return state.logs, state.err
 		},
 		CancelRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
 			return state.err
 		},
-		RerunWorkflowFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+		ForceCancelRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return state.err
+		},
+		RerunWorkflowFunc: func(ctx context.Context, repo github.Repository, runID int64, opts *github.RerunOpts) error {
 			return state.err
 		},
-		RerunFailedJobsFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+		RerunFailedJobsFunc: func(ctx context.Context, repo github.Repository, runID int64, opts *github.RerunOpts) error {
 			return state.err
 		},
 		TriggerWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowFile, ref string, inputs map[string]interface{}) error {
 			return state.err
 		},
+		DeleteRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return state.err
+		},
+		DeleteRunLogsFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return state.err
+		},
+		ListPendingDeploymentsFunc: func(ctx context.Context, repo github.Repository, runID int64) ([]github.PendingDeployment, error) {
+			return state.deployments, state.err
+		},
+		ReviewPendingDeploymentsFunc: func(ctx context.Context, repo github.Repository, runID int64, environmentIDs []int64, approve bool, comment string) error {
+			return state.err
+		},
 		RateLimitRemainingFunc: func() int {
 			if state.rateLimit > 0 {
 				return state.rateLimit
 			}
 			return 5000
 		},
+		RateLimitFunc: func() github.RateLimit {
+			remaining := 5000
+			if state.rateLimit > 0 {
+				remaining = state.rateLimit
+			}
+			return github.RateLimit{Limit: 5000, Remaining: remaining, Resource: "core"}
+		},
 	}
 }
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Billable usage - billable time by runner OS and its estimated cost,
+// shown in the Info tab of workflows and runs
+
+// usageState is the billable usage of a workflow or run, once loaded
+type usageState struct {
+	usage   *github.Usage
+	err     error
+	loading bool
+}
+
+// fetchUsageCmd fetches the usage of the selected workflow and run if not known yet,
+// cancelling the request of the previously selected one.
+// Usage of runs in progress is incomplete, so it is only fetched once they complete.
+// Nothing is fetched while another tab than Info is shown.
+func (a *App) fetchUsageCmd() tea.Cmd {
+	if a.client == nil || a.detailTab != InfoTab {
+		return nil
+	}
+	var cmds []tea.Cmd
+	if wf, ok := a.workflows.Selected(); ok {
+		if _, known := a.workflowUsage[wf.ID]; !known {
+			a.workflowUsage[wf.ID] = usageState{loading: true}
+			ctx := newRequestContext(&a.cancelWorkflowUsage)
+			cmds = append(cmds, fetchWorkflowUsage(ctx, a.client, a.repo, wf.ID))
+		}
+	}
+	if run, ok := a.runs.Selected(); ok && !run.IsRunning() {
+		if _, known := a.runUsage[run.ID]; !known {
+			a.runUsage[run.ID] = usageState{loading: true}
+			ctx := newRequestContext(&a.cancelRunUsage)
+			cmds = append(cmds, fetchRunUsage(ctx, a.client, a.repo, run.ID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// pruneRunUsage forgets the usage of runs that are running again (e.g., after a rerun)
+func (a *App) pruneRunUsage(runs []github.Run) {
+	for _, run := range runs {
+		if run.IsRunning() {
+			delete(a.runUsage, run.ID)
+		}
+	}
+}
+
+// handleUsageLoaded stores the usage of a workflow or run.
+// A cancelled request is forgotten, and made again if the workflow or run
+// is selected again meanwhile.
+func (a *App) handleUsageLoaded(msg UsageLoadedMsg) tea.Cmd {
+	if isCancelled(msg.Err) {
+		if msg.RunID != 0 {
+			delete(a.runUsage, msg.RunID)
+		} else {
+			delete(a.workflowUsage, msg.WorkflowID)
+		}
+		return a.fetchUsageCmd()
+	}
+	state := usageState{usage: msg.Usage, err: msg.Err}
+	if msg.RunID != 0 {
+		a.runUsage[msg.RunID] = state
+	} else {
+		a.workflowUsage[msg.WorkflowID] = state
+	}
+	return nil
+}
+
+// buildUsageContent builds the billable time table of the Info tab
+func (a *App) buildUsageContent(title string, state usageState, known bool) []string {
+	content := []string{"", "  " + title}
+	switch {
+	case !known:
+		return append(content, "    Available when the run completes")
+	case state.loading:
+		return append(content, "    Loading...")
+	case state.err != nil:
+		return append(content, "    Unavailable: "+state.err.Error())
+	}
+
+	u := state.usage
+	if u.RunDuration > 0 {
+		content = append(content, "    Run duration: "+formatCountdown(u.RunDuration))
+	}
+	if len(u.Billable) == 0 {
+		return append(content, "    No billable time")
+	}
+	content = append(content, fmt.Sprintf("    %-8s %8s %10s", "OS", "Time", "Est. cost"))
+	for _, os := range u.OSes() {
+		d := u.Billable[os]
+		content = append(content, fmt.Sprintf("    %-8s %8s %10s", os, formatCountdown(d), formatCost(a.costRates.Cost(os, d))))
+	}
+	if len(u.Billable) > 1 {
+		content = append(content, fmt.Sprintf("    %-8s %8s %10s", "Total", formatCountdown(u.TotalBillable()), formatCost(a.costRates.UsageCost(*u))))
+	}
+	return content
+}
+
+// formatCost formats an estimated cost in USD (e.g., "$1.28")
+func formatCost(usd float64) string {
+	return fmt.Sprintf("$%.2f", usd)
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newUsageApp() (*App, *github.MockClient) {
+	mock := newMockClient(&mockClientState{
+		usage: &github.Usage{Billable: map[string]time.Duration{
+			"UBUNTU": 30 * time.Minute,
+			"MACOS":  10 * time.Minute,
+		}},
+	})
+	app := New(WithClient(mock), WithRepository(github.Repository{Owner: "owner", Name: "repo"}))
+	app.width = 120
+	app.height = 40
+	app.detailTab = InfoTab
+	app.workflows.SetItems([]github.Workflow{{ID: 1, Name: "CI"}})
+	app.runs.SetItems([]github.Run{
+		{ID: 7, RunNumber: 3, Status: "completed", Conclusion: "success"},
+		{ID: 8, RunNumber: 4, Status: "in_progress"},
+	})
+	return app, mock
+}
+
+func TestApp_FetchUsageCmd(t *testing.T) {
+	app, mock := newUsageApp()
+
+	for _, msg := range runCmds(app.fetchUsageCmd()) {
+		app.Update(msg)
+	}
+	if calls := mock.GetWorkflowUsageCalls(); len(calls) != 1 || calls[0].WorkflowID != 1 {
+		t.Errorf("GetWorkflowUsage calls = %v", calls)
+	}
+	if calls := mock.GetRunUsageCalls(); len(calls) != 1 || calls[0].RunID != 7 {
+		t.Errorf("GetRunUsage calls = %v", calls)
+	}
+
+	// Known usage is not fetched again
+	if cmd := app.fetchUsageCmd(); len(runCmds(cmd)) != 0 {
+		t.Error("known usage should not be fetched again")
+	}
+
+	// Runs in progress are not fetched
+	app.runs.SelectNext()
+	if cmd := app.fetchUsageCmd(); len(runCmds(cmd)) != 0 {
+		t.Error("usage of a run in progress should not be fetched")
+	}
+}
+
+func TestApp_UsageCancelled(t *testing.T) {
+	app, _ := newUsageApp()
+	app.fetchUsageCmd()
+	if app.cancelWorkflowUsage == nil || app.cancelRunUsage == nil {
+		t.Fatal("usage requests should be cancellable")
+	}
+
+	// A cancelled request is forgotten, or made again while still selected
+	app.workflows.SetItems([]github.Workflow{{ID: 2, Name: "Deploy"}})
+	app.runs.SetItems(nil)
+	app.Update(UsageLoadedMsg{WorkflowID: 1, Err: context.Canceled})
+	if _, ok := app.workflowUsage[1]; ok {
+		t.Error("cancelled workflow usage should be forgotten")
+	}
+	app.runs.SetItems([]github.Run{{ID: 7, RunNumber: 3, Status: "completed", Conclusion: "success"}})
+	_, cmd := app.Update(UsageLoadedMsg{WorkflowID: 2, RunID: 7, Err: context.Canceled})
+	if state := app.runUsage[7]; !state.loading || cmd == nil {
+		t.Error("cancelled usage of the selected run should be fetched again")
+	}
+}
+
+func TestApp_UsageInfo(t *testing.T) {
+	app, _ := newUsageApp()
+	app.focusedPane = WorkflowsPane
+	for _, msg := range runCmds(app.fetchUsageCmd()) {
+		app.Update(msg)
+	}
+
+	view := app.View()
+	for _, want := range []string{"Billable time (this billing cycle)", "UBUNTU", "$0.24", "MACOS", "$0.80", "Total", "$1.04"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("workflow info should contain %q:\n%s", want, view)
+		}
+	}
+
+	app.focusedPane = RunsPane
+	app.runs.SelectNext()
+	if view := app.View(); !strings.Contains(view, "Available when the run completes") {
+		t.Errorf("run info should explain usage of runs in progress:\n%s", view)
+	}
+}
+
+func TestApp_UsageUnavailable(t *testing.T) {
+	app, _ := newUsageApp()
+	app.focusedPane = WorkflowsPane
+	app.Update(UsageLoadedMsg{WorkflowID: 1, Err: &github.AppError{Type: github.ErrTypeAuth, Message: "Permission denied"}})
+
+	if app.err != nil {
+		t.Error("usage errors should not be shown in the status bar")
+	}
+	if view := app.View(); !strings.Contains(view, "Unavailable: Permission denied") {
+		t.Errorf("info should show why usage is unavailable:\n%s", view)
+	}
+}
+
+func TestApp_PruneRunUsage(t *testing.T) {
+	app, _ := newUsageApp()
+	app.runUsage[7] = usageState{usage: &github.Usage{}}
+
+	app.pruneRunUsage([]github.Run{{ID: 7, Status: "queued"}})
+	if _, ok := app.runUsage[7]; ok {
+		t.Error("usage of a rerun run should be forgotten")
+	}
+}
+
+func TestApp_FetchUsageCmd_OnlyInInfoTab(t *testing.T) {
+	app, mock := newUsageApp()
+	app.detailTab = LogsTab
+
+	if cmd := app.fetchUsageCmd(); cmd != nil {
+		t.Error("usage should only be fetched for the Info tab")
+	}
+
+	cmd := app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	for _, msg := range runCmds(cmd) {
+		app.Update(msg)
+	}
+	if calls := mock.GetWorkflowUsageCalls(); len(calls) != 1 {
+		t.Errorf("GetWorkflowUsage calls = %v, want one once the Info tab is shown", calls)
+	}
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/notify"
+)
+
+// Watched runs - notify when runs complete
+
+// toggleWatchRun starts or stops watching the selected run
+func (a *App) toggleWatchRun() tea.Cmd {
+	run, ok := a.runs.Selected()
+	if !ok {
+		return nil
+	}
+
+	if a.watchedRuns[run.ID] {
+		delete(a.watchedRuns, run.ID)
+		return flashMessage("Stopped watching #"+strconv.Itoa(run.RunNumber), FlashDurationSuccess)
+	}
+	a.watchedRuns[run.ID] = true
+	a.runStatus[run.ID] = run.Status
+	return flashMessage("Watching #"+strconv.Itoa(run.RunNumber), FlashDurationSuccess)
+}
+
+// toggleWatchBranch starts or stops watching all runs on the local branch
+func (a *App) toggleWatchBranch() tea.Cmd {
+	if a.branch == "" {
+		return flashMessage("No local branch to watch", FlashDurationInfo)
+	}
+
+	a.watchBranch = !a.watchBranch
+	if !a.watchBranch {
+		return flashMessage("Stopped watching "+a.branch, FlashDurationSuccess)
+	}
+	a.watchSince = time.Now()
+	return tea.Batch(
+		flashMessage("Watching all runs on "+a.branch, FlashDurationSuccess),
+		a.fetchWatchedRunsCmd(),
+	)
+}
+
+// isWatched returns true if run is watched individually or through the branch
+func (a *App) isWatched(run github.Run) bool {
+	return a.watchedRuns[run.ID] || (a.watchBranch && run.Branch == a.branch)
+}
+
+// isWatching returns true if anything is being watched
+func (a *App) isWatching() bool {
+	return len(a.watchedRuns) > 0 || a.watchBranch
+}
+
+// watchStatus returns the status bar indicator, or "" if nothing is watched
+func (a *App) watchStatus() string {
+	var parts []string
+	if n := len(a.watchedRuns); n == 1 {
+		parts = append(parts, "1 run")
+	} else if n > 1 {
+		parts = append(parts, strconv.Itoa(n)+" runs")
+	}
+	if a.watchBranch {
+		parts = append(parts, a.branch)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "◉ " + strings.Join(parts, ", ")
+}
+
+// fetchWatchedRunsCmd fetches the watched runs so completions are noticed
+// even when they are not in the runs pane
+func (a *App) fetchWatchedRunsCmd() tea.Cmd {
+	if a.client == nil || !a.isWatching() {
+		return nil
+	}
+
+	var branch string
+	if a.watchBranch {
+		branch = a.branch
+	}
+	ids := make([]int64, 0, len(a.watchedRuns))
+	for id := range a.watchedRuns {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	ctx := newRequestContext(&a.cancelWatch)
+	return fetchWatchedRuns(ctx, a.client, a.repo, branch, ids)
+}
+
+// observeRuns records the status of watched runs and notifies when they complete.
+// Individually watched runs stop being watched once they complete.
+func (a *App) observeRuns(runs []github.Run, now time.Time) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, run := range runs {
+		if !a.isWatched(run) {
+			continue
+		}
+		prev, seen := a.runStatus[run.ID]
+		a.runStatus[run.ID] = run.Status
+		if run.Status != "completed" {
+			continue
+		}
+
+		// Branch runs that started and finished between two polls are never
+		// seen running, so they are recognized by their creation time instead
+		finished := seen && prev != "completed"
+		finishedUnseen := !seen && a.watchBranch && run.Branch == a.branch && run.CreatedAt.After(a.watchSince)
+		if !finished && !finishedUnseen {
+			continue
+		}
+
+		delete(a.watchedRuns, run.ID)
+		cmds = append(cmds, a.notifyRunCompleted(run, now))
+	}
+	return tea.Batch(cmds...)
+}
+
+// notifyRunCompleted sends the completion notification for run.
+// Failures are also flashed in the status bar.
+func (a *App) notifyRunCompleted(run github.Run, now time.Time) tea.Cmd {
+	summary := runSummary(run, now)
+	failed := run.Conclusion != "success"
+
+	var cmds []tea.Cmd
+	if a.notifier != nil {
+		cmds = append(cmds, sendNotification(a.notifier, notify.Notification{
+			Title:  a.repo.Owner + "/" + a.repo.Name,
+			Body:   summary,
+			Failed: failed,
+		}))
+	}
+	if failed {
+		cmds = append(cmds, flashMessage(StatusIcon(run.Status, run.Conclusion)+" "+summary, FlashDurationInfo))
+	}
+	return tea.Batch(cmds...)
+}
+
+// runSummary describes a completed run (e.g., "CI #21 failed on main in 3m")
+func runSummary(run github.Run, now time.Time) string {
+	var outcome string
+	switch run.Conclusion {
+	case "success":
+		outcome = "succeeded"
+	case "failure":
+		outcome = "failed"
+	case "cancelled":
+		outcome = "was cancelled"
+	case "timed_out":
+		outcome = "timed out"
+	default:
+		outcome = "finished (" + run.Conclusion + ")"
+	}
+
+	summary := fmt.Sprintf("%s #%d %s", run.Name, run.RunNumber, outcome)
+	if run.Branch != "" {
+		summary += " on " + run.Branch
+	}
+	if d := run.Duration(now); d > 0 {
+		summary += " in " + formatCountdown(d)
+	}
+	return summary
+}
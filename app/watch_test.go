@@ -0,0 +1,246 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/notify"
+)
+
+// recordingNotifier records delivered notifications
+type recordingNotifier struct {
+	mu  sync.Mutex
+	got []notify.Notification
+}
+
+func (r *recordingNotifier) Notify(n notify.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, n)
+	return nil
+}
+
+// runCmds executes cmd and any batched commands and returns their messages.
+// Delayed commands such as the flash clear tick are skipped.
+func runCmds(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(50 * time.Millisecond):
+		return nil
+	}
+
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, runCmds(c)...)
+	}
+	return msgs
+}
+
+func newWatchApp(n notify.Notifier) *App {
+	app := New(
+		WithRepository(github.Repository{Owner: "owner", Name: "repo"}),
+		WithNotifier(n),
+		WithBranch("main"),
+	)
+	app.focusedPane = RunsPane
+	return app
+}
+
+func TestApp_ToggleWatchRun(t *testing.T) {
+	app := newWatchApp(nil)
+	app.runs.SetItems([]github.Run{{ID: 1, RunNumber: 7, Status: "in_progress"}})
+
+	if cmd := app.toggleWatchRun(); cmd == nil {
+		t.Error("expected a flash message")
+	}
+	if !app.watchedRuns[1] {
+		t.Fatal("run should be watched")
+	}
+	if !strings.Contains(app.watchStatus(), "1 run") {
+		t.Errorf("watchStatus() = %q", app.watchStatus())
+	}
+
+	app.toggleWatchRun()
+	if app.watchedRuns[1] {
+		t.Error("run should no longer be watched")
+	}
+	if app.watchStatus() != "" {
+		t.Errorf("watchStatus() = %q, want empty", app.watchStatus())
+	}
+}
+
+func TestApp_ObserveRuns_NotifiesOnCompletion(t *testing.T) {
+	notifier := &recordingNotifier{}
+	app := newWatchApp(notifier)
+	created := time.Now().Add(-3 * time.Minute)
+	app.runs.SetItems([]github.Run{{ID: 1, RunNumber: 7, Name: "CI", Status: "in_progress", CreatedAt: created}})
+	app.toggleWatchRun()
+
+	// Still running: nothing to report
+	if cmd := app.observeRuns([]github.Run{{ID: 1, Status: "in_progress"}}, time.Now()); cmd != nil {
+		t.Error("expected no notification while running")
+	}
+
+	done := github.Run{ID: 1, RunNumber: 7, Name: "CI", Branch: "main", Status: "completed", Conclusion: "success",
+		CreatedAt: created, UpdatedAt: created.Add(2 * time.Minute)}
+	runCmds(app.observeRuns([]github.Run{done}, time.Now()))
+
+	if len(notifier.got) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifier.got))
+	}
+	n := notifier.got[0]
+	if n.Title != "owner/repo" || n.Body != "CI #7 succeeded on main in 2m" || n.Failed {
+		t.Errorf("notification = %+v", n)
+	}
+	if app.watchedRuns[1] {
+		t.Error("individually watched run should be unwatched after completing")
+	}
+
+	// Completion is reported once
+	if cmd := app.observeRuns([]github.Run{done}, time.Now()); cmd != nil {
+		t.Error("expected no second notification")
+	}
+}
+
+func TestApp_ObserveRuns_FailureFlashes(t *testing.T) {
+	notifier := &recordingNotifier{}
+	app := newWatchApp(notifier)
+	app.runs.SetItems([]github.Run{{ID: 1, RunNumber: 7, Name: "CI", Status: "queued"}})
+	app.toggleWatchRun()
+
+	msgs := runCmds(app.observeRuns([]github.Run{{ID: 1, RunNumber: 7, Name: "CI", Status: "completed", Conclusion: "failure"}}, time.Now()))
+
+	var flash string
+	for _, msg := range msgs {
+		if f, ok := msg.(FlashMsg); ok {
+			flash = f.Message
+		}
+	}
+	if !strings.Contains(flash, "CI #7 failed") {
+		t.Errorf("flash = %q, want failure message (msgs: %v)", flash, msgs)
+	}
+	if len(notifier.got) != 1 || !notifier.got[0].Failed {
+		t.Errorf("notifications = %+v, want one failure", notifier.got)
+	}
+}
+
+func TestApp_ObserveRuns_AlreadyCompletedIsIgnored(t *testing.T) {
+	notifier := &recordingNotifier{}
+	app := newWatchApp(notifier)
+	app.watchBranch = true
+	app.watchSince = time.Now()
+
+	old := github.Run{ID: 1, Branch: "main", Status: "completed", Conclusion: "success", CreatedAt: app.watchSince.Add(-time.Hour)}
+	if cmd := app.observeRuns([]github.Run{old}, time.Now()); cmd != nil {
+		t.Error("runs completed before watching started should not notify")
+	}
+}
+
+func TestApp_ObserveRuns_BranchRunFinishedBetweenPolls(t *testing.T) {
+	notifier := &recordingNotifier{}
+	app := newWatchApp(notifier)
+	app.watchBranch = true
+	app.watchSince = time.Now().Add(-time.Minute)
+
+	quick := github.Run{ID: 2, Name: "Lint", Branch: "main", Status: "completed", Conclusion: "success", CreatedAt: time.Now()}
+	other := github.Run{ID: 3, Name: "Lint", Branch: "feature", Status: "completed", Conclusion: "success", CreatedAt: time.Now()}
+	runCmds(app.observeRuns([]github.Run{quick, other}, time.Now()))
+
+	if len(notifier.got) != 1 || !strings.HasPrefix(notifier.got[0].Body, "Lint #0 succeeded on main") {
+		t.Errorf("notifications = %+v, want one for the branch run", notifier.got)
+	}
+}
+
+func TestApp_ToggleWatchBranch(t *testing.T) {
+	mock := newMockClient(&mockClientState{})
+	app := New(WithClient(mock), WithBranch("main"))
+
+	app.toggleWatchBranch()
+	if !app.watchBranch || app.watchSince.IsZero() {
+		t.Fatal("branch should be watched")
+	}
+	if !app.isWatched(github.Run{ID: 9, Branch: "main"}) || app.isWatched(github.Run{ID: 9, Branch: "dev"}) {
+		t.Error("only runs on main should be watched")
+	}
+	if !strings.Contains(app.watchStatus(), "main") {
+		t.Errorf("watchStatus() = %q", app.watchStatus())
+	}
+
+	app.toggleWatchBranch()
+	if app.watchBranch {
+		t.Error("branch should no longer be watched")
+	}
+}
+
+func TestApp_ToggleWatchBranch_NoBranch(t *testing.T) {
+	app := New()
+	app.toggleWatchBranch()
+	if app.watchBranch {
+		t.Error("cannot watch without a local branch")
+	}
+}
+
+func TestApp_PollTickFetchesWatchedRuns(t *testing.T) {
+	mock := newMockClient(&mockClientState{})
+	app := New(WithClient(mock))
+	app.loading = true // the regular refresh is skipped
+	app.watchedRuns[5] = true
+
+	if app.handlePollTick(time.Now()) == nil {
+		t.Fatal("expected commands")
+	}
+	if app.cancelWatch == nil {
+		t.Error("expected a watched runs request to be started")
+	}
+}
+
+func TestFetchWatchedRuns(t *testing.T) {
+	mock := newMockClient(&mockClientState{
+		runs: []github.Run{{ID: 1, Branch: "main"}, {ID: 5, Branch: "dev"}},
+	})
+	mock.ListRunsFunc = func(ctx context.Context, repo github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
+		return []github.Run{{ID: 1, Branch: "main"}}, nil
+	}
+	repo := github.Repository{Owner: "owner", Name: "repo"}
+
+	msg := fetchWatchedRuns(context.Background(), mock, repo, "main", []int64{1, 5})()
+	result, ok := msg.(WatchedRunsLoadedMsg)
+	if !ok {
+		t.Fatalf("expected WatchedRunsLoadedMsg, got %T", msg)
+	}
+	if result.Err != nil {
+		t.Fatalf("unexpected error: %v", result.Err)
+	}
+	if calls := mock.ListRunsCalls(); len(calls) != 1 || calls[0].Opts.Branch != "main" {
+		t.Errorf("ListRuns calls = %v, want one for main", calls)
+	}
+	// Run 1 came with the branch listing; only run 5 is fetched individually
+	if calls := mock.GetRunCalls(); len(calls) != 1 || calls[0].RunID != 5 {
+		t.Errorf("GetRun calls = %v, want only run 5", calls)
+	}
+}
+
+func TestApp_FlashMsgIsShown(t *testing.T) {
+	app := New()
+	app.width = 80
+	app.Update(FlashMsg{Message: "Copied", Duration: time.Second})
+	if app.flashMsg != "Copied" {
+		t.Errorf("flashMsg = %q, want Copied", app.flashMsg)
+	}
+}
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Yank menu - copies details of the selection to the clipboard,
+// one key per value: y copies the URL again, so yy keeps copying URLs
+
+// yankItem is a value the yank menu can copy
+type yankItem struct {
+	key   string
+	label string
+	value string
+}
+
+// yankMenu is the state of the yank menu
+type yankMenu struct {
+	items []yankItem
+}
+
+// openYankMenu opens the yank menu with what can be copied from the selection
+func (a *App) openYankMenu() tea.Cmd {
+	items := a.yankItems(time.Now())
+	if len(items) == 0 {
+		return nil
+	}
+	a.yanking = &yankMenu{items: items}
+	return nil
+}
+
+// yankItems returns the values of the selection: its URL, details of the
+// selected run and job, the log lines in view, gh commands, and a summary
+func (a *App) yankItems(now time.Time) []yankItem {
+	var items []yankItem
+	add := func(key, label, value string) {
+		if value != "" {
+			items = append(items, yankItem{key: key, label: label, value: value})
+		}
+	}
+
+	add("y", "URL", a.browserURL())
+
+	run, hasRun := a.runs.Selected()
+	if hasRun {
+		add("i", "Run ID", strconv.FormatInt(run.ID, 10))
+		add("n", "Run number", strconv.Itoa(run.RunNumber))
+		add("s", "Head SHA", run.HeadSHA)
+		add("b", "Branch", run.Branch)
+	}
+
+	job, hasJob := a.jobs.Selected()
+	if hasJob {
+		add("j", "Job URL", job.URL)
+		if step := a.selectedStepNumber(job); step > 0 && job.URL != "" {
+			add("p", "Step link", stepURL(job.URL, step, 1))
+		}
+	}
+	if a.detailTab == LogsTab && a.focusedPane == JobsPane && a.parsedLogs != nil {
+		if a.logView.Selecting() {
+			add("l", "Selected log lines", a.logView.SelectedText())
+		} else {
+			add("l", "Log lines in view", a.logView.VisibleText())
+		}
+	}
+
+	if hasRun {
+		onJob := hasJob && a.focusedPane == JobsPane
+		add("r", "Rerun command", a.ghRunCommand("rerun", run, job, onJob))
+		add("v", "View command", a.ghRunCommand("view", run, job, onJob))
+		add("m", "Markdown summary", runMarkdown(run, a.jobs.Items(), now))
+	}
+	return items
+}
+
+// ghRunCommand returns the gh command line of a run subcommand,
+// limited to the job when onJob is set
+func (a *App) ghRunCommand(subcommand string, run github.Run, job github.Job, onJob bool) string {
+	args := []string{"gh", "run", subcommand, strconv.FormatInt(run.ID, 10)}
+	if onJob {
+		args = append(args, "--job", strconv.FormatInt(job.ID, 10))
+		if subcommand == "view" {
+			args = append(args, "--log")
+		}
+	}
+	return strings.Join(append(args, "--repo", a.repo.Owner+"/"+a.repo.Name), " ")
+}
+
+// outcome returns the conclusion of a completed run or job, or its status
+func outcome(status, conclusion string) string {
+	if status == "completed" && conclusion != "" {
+		return conclusion
+	}
+	return strings.ReplaceAll(status, "_", " ")
+}
+
+// runMarkdown returns a Markdown summary of a run and its jobs
+func runMarkdown(run github.Run, jobs []github.Job, now time.Time) string {
+	title := fmt.Sprintf("%s #%d", run.Name, run.RunNumber)
+	if run.URL != "" {
+		title = "[" + title + "](" + run.URL + ")"
+	}
+	lines := []string{fmt.Sprintf("**%s**: %s", title, outcome(run.Status, run.Conclusion))}
+
+	if run.Branch != "" {
+		lines = append(lines, fmt.Sprintf("- Branch: `%s` at `%s`", run.Branch, shortSHA(run.HeadSHA)))
+	}
+	if run.Event != "" {
+		trigger := "- Trigger: " + run.Event
+		if run.Actor != "" {
+			trigger += " by @" + run.Actor
+		}
+		lines = append(lines, trigger)
+	}
+	for _, pr := range run.PullRequests {
+		lines = append(lines, fmt.Sprintf("- Pull request: #%d", pr))
+	}
+	if run.Attempt > 1 {
+		lines = append(lines, fmt.Sprintf("- Attempt: %d", run.Attempt))
+	}
+	if d := run.Duration(now); d > 0 {
+		lines = append(lines, "- Duration: "+formatCountdown(d))
+	}
+
+	if len(jobs) > 0 {
+		lines = append(lines, "- Jobs:")
+		for _, job := range jobs {
+			name := job.Name
+			if job.URL != "" {
+				name = "[" + name + "](" + job.URL + ")"
+			}
+			lines = append(lines, fmt.Sprintf("  - %s: %s", name, outcome(job.Status, job.Conclusion)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// handleYankInput handles input when in the yank menu
+func (a *App) handleYankInput(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	if key == "esc" || key == "q" {
+		a.yanking = nil
+		return nil
+	}
+	for _, item := range a.yanking.items {
+		if item.key == key {
+			a.yanking = nil
+			if key == "l" {
+				a.logView.ClearSelection()
+			}
+			return a.copyValue(item)
+		}
+	}
+	return nil
+}
+
+// copyValue copies the value of a yank menu item to clipboard
+func (a *App) copyValue(item yankItem) tea.Cmd {
+	multiline := strings.Contains(item.value, "\n")
+	if err := a.clipboard.WriteAll(item.value); err != nil {
+		// Clipboard not available (e.g., headless environment)
+		// Show single-line values in flash message so user can copy manually
+		if multiline {
+			return flashMessage("Clipboard not available", FlashDurationInfo)
+		}
+		return flashMessage(item.label+": "+item.value, FlashDurationInfo)
+	}
+	if multiline {
+		return flashMessage("Copied: "+item.label, FlashDurationSuccess)
+	}
+	return flashMessage("Copied: "+item.value, FlashDurationSuccess)
+}
+
+// yankPreview returns a value shortened to fit on one line of width
+func yankPreview(value string, width int) string {
+	if n := strings.Count(value, "\n") + 1; n > 1 {
+		return fmt.Sprintf("(%d lines)", n)
+	}
+	return truncateString(value, width)
+}
+
+// renderYankMenu renders the yank menu
+func (a *App) renderYankMenu() string {
+	const dialogWidth, labelWidth = 72, 18
+
+	keyStyle := lipgloss.NewStyle().Bold(true)
+	lines := []string{lipgloss.NewStyle().Bold(true).Render("Copy to clipboard"), ""}
+	for _, item := range a.yanking.items {
+		preview := yankPreview(item.value, dialogWidth-labelWidth-12)
+		lines = append(lines, fmt.Sprintf("%s %-*s %s",
+			keyStyle.Render("["+item.key+"]"), labelWidth, item.label, NormalItem.Render(preview)))
+	}
+	lines = append(lines, "", "[Esc] Cancel")
+
+	dialog := ConfirmDialog.Width(dialogWidth).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height,
+		lipgloss.Center, lipgloss.Center, dialog)
+}
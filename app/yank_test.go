@@ -0,0 +1,200 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func newYankTestApp(cb Clipboard) *App {
+	app := New(WithRepository(github.Repository{Owner: "owner", Name: "repo"}), WithClipboard(cb))
+	app.width, app.height = 120, 40
+	app.runs.SetItems([]github.Run{{
+		ID: 10, RunNumber: 7, Name: "CI", Status: "completed", Conclusion: "failure",
+		Branch: "main", HeadSHA: "abc1234def", Event: "push", Actor: "octocat",
+		URL: "https://github.com/owner/repo/actions/runs/10",
+	}})
+	app.jobs.SetItems([]github.Job{{
+		ID: 100, Name: "test", Status: "completed", Conclusion: "failure",
+		URL:   "https://github.com/owner/repo/actions/runs/10/job/100",
+		Steps: []github.Step{{Name: "make test", Number: 3}},
+	}})
+	return app
+}
+
+// yankKeys returns the keys of the yank menu items
+func yankKeys(items []yankItem) string {
+	var keys []string
+	for _, item := range items {
+		keys = append(keys, item.key)
+	}
+	return strings.Join(keys, "")
+}
+
+func TestApp_YankMenu(t *testing.T) {
+	cb := &mockClipboard{}
+	app := newYankTestApp(cb)
+	app.focusedPane = RunsPane
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if app.yanking == nil {
+		t.Fatal("y should open the yank menu")
+	}
+	view := app.View()
+	for _, want := range []string{"Copy to clipboard", "[i] Run ID", "abc1234def", "gh run rerun 10 --repo owner/repo", "(5 lines)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("yank menu should contain %q:\n%s", want, view)
+		}
+	}
+
+	msgs := runCmds(app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}}))
+	if cb.content != "abc1234def" {
+		t.Errorf("copied %q, want the head SHA", cb.content)
+	}
+	if app.yanking != nil {
+		t.Error("copying should close the yank menu")
+	}
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "Copied: abc1234def" {
+		t.Errorf("msgs = %v, want a flash message", msgs)
+	}
+
+	// yy copies the URL
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cb.content != "https://github.com/owner/repo/actions/runs/10" {
+		t.Errorf("copied %q, want the run URL", cb.content)
+	}
+
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.yanking != nil {
+		t.Error("Esc should close the yank menu")
+	}
+}
+
+func TestApp_YankItems_Jobs(t *testing.T) {
+	app := newYankTestApp(&mockClipboard{})
+	app.focusedPane = JobsPane
+	app.detailTab = LogsTab
+	app.parsedLogs = ParseLogs("##[group]Run make test\nok\n##[endgroup]")
+	app.selectedStepIdx = 0
+	app.updateLogViewContent()
+
+	items := app.yankItems(time.Now())
+	if got := yankKeys(items); got != "yinsbjplrvm" {
+		t.Fatalf("items = %s, want every item", got)
+	}
+	values := make(map[string]string)
+	for _, item := range items {
+		values[item.key] = item.value
+	}
+	want := map[string]string{
+		"p": "https://github.com/owner/repo/actions/runs/10/job/100#step:3:1",
+		"r": "gh run rerun 10 --job 100 --repo owner/repo",
+		"v": "gh run view 10 --job 100 --log --repo owner/repo",
+	}
+	for key, value := range want {
+		if values[key] != value {
+			t.Errorf("[%s] = %q, want %q", key, values[key], value)
+		}
+	}
+	if !strings.Contains(values["l"], "ok") {
+		t.Errorf("log lines = %q, want the lines in view", values["l"])
+	}
+
+	// Nothing selected: nothing to copy
+	app = New()
+	if cmd := app.openYankMenu(); cmd != nil || app.yanking != nil {
+		t.Error("yank menu should not open without a selection")
+	}
+}
+
+func TestApp_CopySelectedLogLines(t *testing.T) {
+	cb := &mockClipboard{}
+	app := newYankTestApp(cb)
+	app.focusedPane = JobsPane
+	app.detailTab = LogsTab
+	app.parsedLogs = ParseLogs("##[group]Run make test\nok  pkg/a\nok  pkg/b\nFAIL pkg/c\n##[endgroup]")
+	app.selectedStepIdx = 0
+	app.updateLogViewContent()
+	app.logView.GotoTop()
+	app.stepListFocused = false
+
+	key := func(r rune) tea.Cmd {
+		return app.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	key('v')
+	for range 3 {
+		app.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if view := app.View(); !strings.Contains(view, "VISUAL LINE (4)") {
+		t.Errorf("logs should show the selection:\n%s", view)
+	}
+	if items := app.yankItems(time.Now()); !strings.Contains(yankKeys(items), "l") {
+		t.Errorf("items = %s, want the selected lines", yankKeys(items))
+	}
+
+	msgs := runCmds(key('y'))
+	if !strings.HasSuffix(cb.content, "\nok  pkg/a\nok  pkg/b\nFAIL pkg/c") || strings.Count(cb.content, "\n") != 3 {
+		t.Errorf("copied %q, want the selected lines", cb.content)
+	}
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "Copied: 4 log lines" {
+		t.Errorf("msgs = %v, want a flash message", msgs)
+	}
+	if app.logView.Selecting() || app.yanking != nil {
+		t.Error("copying should end the selection without opening the menu")
+	}
+
+	// Esc ends the selection before leaving the log content
+	key('v')
+	app.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.logView.Selecting() || app.stepListFocused {
+		t.Error("Esc should only end the selection")
+	}
+}
+
+func TestRunMarkdown(t *testing.T) {
+	created := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	run := github.Run{
+		ID: 10, RunNumber: 7, Name: "CI", Attempt: 2, Status: "completed", Conclusion: "failure",
+		Branch: "main", HeadSHA: "abc1234def", Event: "pull_request", Actor: "octocat",
+		URL: "https://x/runs/10", PullRequests: []int{42},
+		CreatedAt: created, UpdatedAt: created.Add(3 * time.Minute),
+	}
+	jobs := []github.Job{
+		{Name: "test", Status: "completed", Conclusion: "failure", URL: "https://x/job/1"},
+		{Name: "deploy", Status: "in_progress"},
+	}
+
+	want := strings.Join([]string{
+		"**[CI #7](https://x/runs/10)**: failure",
+		"- Branch: `main` at `abc1234`",
+		"- Trigger: pull_request by @octocat",
+		"- Pull request: #42",
+		"- Attempt: 2",
+		"- Duration: 3m",
+		"- Jobs:",
+		"  - [test](https://x/job/1): failure",
+		"  - deploy: in progress",
+	}, "\n")
+	if got := runMarkdown(run, jobs, created); got != want {
+		t.Errorf("runMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApp_CopyValue_Unavailable(t *testing.T) {
+	app := newYankTestApp(&mockClipboard{err: errors.New("no clipboard")})
+
+	msgs := runCmds(app.copyValue(yankItem{label: "Branch", value: "main"}))
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "Branch: main" {
+		t.Errorf("msgs = %v, want the value shown instead", msgs)
+	}
+	msgs = runCmds(app.copyValue(yankItem{label: "Markdown summary", value: "a\nb"}))
+	if len(msgs) != 1 || msgs[0].(FlashMsg).Message != "Clipboard not available" {
+		t.Errorf("msgs = %v, want multi-line values left out", msgs)
+	}
+}
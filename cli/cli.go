@@ -0,0 +1,208 @@
+// Package cli implements the non-interactive lazyactions subcommands.
+// They reuse the same GitHub client as the TUI and are meant for scripts.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Exit codes returned by Run. They are stable so scripts can branch on them.
+const (
+	ExitOK        = 0  // Command succeeded
+	ExitFailure   = 1  // Unexpected error
+	ExitUsage     = 2  // Invalid command line
+	ExitAuth      = 3  // Authentication failed or access denied
+	ExitNotFound  = 4  // Workflow, run or job not found
+	ExitRateLimit = 5  // Rate limit exceeded
+	ExitNetwork   = 6  // GitHub unreachable
+	ExitServer    = 7  // GitHub server error
+	ExitRunFailed = 8  // A watched run did not conclude successfully
+	ExitTimeout   = 9  // Gave up waiting for runs to finish
+	ExitLint      = 10 // Lint found problems in workflow files
+)
+
+// Env holds the dependencies shared by all subcommands.
+type Env struct {
+	Client  github.Client
+	Repo    github.Repository
+	Stdout  io.Writer
+	Stderr  io.Writer
+	HeadSHA func() (string, error) // Resolves the local HEAD commit for watch
+	// RepoRoot resolves the top-level directory of the local clone for lint
+	RepoRoot func() (string, error)
+}
+
+// command is a single subcommand
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, env *Env, args []string) error
+}
+
+// commands lists the subcommands in the order shown by help
+var commands = []command{
+	{"workflows list", "workflows list [--json]", runWorkflowsList},
+	{"runs list", "runs list [--workflow ID|FILE|NAME] [--branch B] [--event E] [--status S] [--limit N] [--json]", runRunsList},
+	{"jobs list", "jobs list RUN_ID [--attempt N] [--json]", runJobsList},
+	{"jobs rerun", "jobs rerun JOB_ID [--debug] [--json]", runJobsRerun},
+	{"logs", "logs JOB_ID [--step N|NAME]", runLogs},
+	{"cancel", "cancel RUN_ID [--force] [--json]", runCancel},
+	{"rerun", "rerun RUN_ID [--failed] [--debug] [--json]", runRerun},
+	{"dispatch", "dispatch WORKFLOW [--ref REF] [--input KEY=VALUE]... [--json]", runDispatch},
+	{"watch", "watch [--sha SHA] [--workflow ID|FILE|NAME] [--timeout D] [--interval D] [--fail-fast]", runWatch},
+	{"lint", "lint [--json]", runLint},
+}
+
+// usageError reports an invalid command line
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// usagef returns a usage error with a formatted message
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// IsCommand returns true if name starts a known subcommand
+func IsCommand(name string) bool {
+	for _, c := range commands {
+		if strings.Fields(c.name)[0] == name {
+			return true
+		}
+	}
+	return name == "help"
+}
+
+// Run executes the subcommand in args and returns the process exit code.
+// Errors are written to env.Stderr.
+func Run(ctx context.Context, env *Env, args []string) int {
+	if len(args) == 0 || args[0] == "help" {
+		printUsage(env.Stdout)
+		return ExitOK
+	}
+
+	cmd, rest, ok := findCommand(args)
+	if !ok {
+		fmt.Fprintf(env.Stderr, "Error: unknown command %q\n\n", strings.Join(args, " "))
+		printUsage(env.Stderr)
+		return ExitUsage
+	}
+
+	if err := cmd.run(ctx, env, rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		fmt.Fprintf(env.Stderr, "Error: %v\n", err)
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprintf(env.Stderr, "Usage: lazyactions %s\n", cmd.usage)
+		}
+		return ExitCode(err)
+	}
+	return ExitOK
+}
+
+// findCommand matches the longest subcommand name at the start of args
+func findCommand(args []string) (command, []string, bool) {
+	for _, c := range commands {
+		words := strings.Fields(c.name)
+		if len(args) < len(words) {
+			continue
+		}
+		if strings.Join(args[:len(words)], " ") == c.name {
+			return c, args[len(words):], true
+		}
+	}
+	return command{}, nil, false
+}
+
+// printUsage prints the list of subcommands
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: lazyactions [flags] [command]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Without a command the interactive UI is started.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s\n", c.usage)
+	}
+}
+
+// ExitCode maps an error to the exit code reported for it
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		return ExitUsage
+	}
+	if errors.Is(err, errRunsFailed) {
+		return ExitRunFailed
+	}
+	if errors.Is(err, errWatchTimeout) {
+		return ExitTimeout
+	}
+	if errors.Is(err, errLintIssues) {
+		return ExitLint
+	}
+
+	var appErr *github.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Type {
+		case github.ErrTypeAuth:
+			return ExitAuth
+		case github.ErrTypeNotFound:
+			return ExitNotFound
+		case github.ErrTypeRateLimit:
+			return ExitRateLimit
+		case github.ErrTypeNetwork:
+			return ExitNetwork
+		case github.ErrTypeServer:
+			return ExitServer
+		}
+	}
+
+	if github.IsNetworkError(err) {
+		return ExitNetwork
+	}
+	return ExitFailure
+}
+
+// newFlagSet creates a flag set that reports errors instead of exiting
+func newFlagSet(name string, env *Env) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.Stderr)
+	return fs
+}
+
+// parseArgs parses flags and returns the positional arguments.
+// Unlike flag.Parse, flags may follow positional arguments (e.g., "jobs list 123 --json").
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{msg: err.Error()}
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+var testRepo = github.Repository{Owner: "owner", Name: "repo"}
+
+var testWorkflows = []github.Workflow{
+	{ID: 1, Name: "CI", Path: ".github/workflows/ci.yml", State: "active"},
+	{ID: 2, Name: "Deploy", Path: ".github/workflows/deploy.yml", State: "active"},
+}
+
+// newTestMock returns a mock client whose calls all succeed with empty results
+func newTestMock() *github.MockClient {
+	return &github.MockClient{
+		GetDefaultBranchFunc: func(ctx context.Context, repo github.Repository) (string, error) {
+			return "main", nil
+		},
+		GetFileContentFunc: func(ctx context.Context, repo github.Repository, path, ref string) (string, error) {
+			return "", nil
+		},
+		ListWorkflowsFunc: func(ctx context.Context, repo github.Repository) ([]github.Workflow, error) {
+			return testWorkflows, nil
+		},
+		EnableWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowID int64) error {
+			return nil
+		},
+		DisableWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowID int64) error {
+			return nil
+		},
+		GetWorkflowUsageFunc: func(ctx context.Context, repo github.Repository, workflowID int64) (*github.Usage, error) {
+			return &github.Usage{}, nil
+		},
+		ListRunsFunc: func(ctx context.Context, repo github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
+			return nil, nil
+		},
+		GetRunFunc: func(ctx context.Context, repo github.Repository, runID int64) (*github.Run, error) {
+			return &github.Run{ID: runID}, nil
+		},
+		GetRunUsageFunc: func(ctx context.Context, repo github.Repository, runID int64) (*github.Usage, error) {
+			return &github.Usage{}, nil
+		},
+		GetRunAttemptFunc: func(ctx context.Context, repo github.Repository, runID int64, attempt int) (*github.Run, error) {
+			return &github.Run{ID: runID, Attempt: attempt}, nil
+		},
+		ListJobsFunc: func(ctx context.Context, repo github.Repository, runID int64) ([]github.Job, error) {
+			return nil, nil
+		},
+		ListJobsForAttemptFunc: func(ctx context.Context, repo github.Repository, runID int64, attempt int) ([]github.Job, error) {
+			return nil, nil
+		},
+		RerunJobFunc: func(ctx context.Context, repo github.Repository, jobID int64, opts *github.RerunOpts) error {
+			return nil
+		},
+		GetJobLogsFunc: func(ctx context.Context, repo github.Repository, jobID int64) (string, error) {
+			return "", nil
+		},
+		CancelRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return nil
+		},
+		ForceCancelRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return nil
+		},
+		RerunWorkflowFunc: func(ctx context.Context, repo github.Repository, runID int64, opts *github.RerunOpts) error {
+			return nil
+		},
+		RerunFailedJobsFunc: func(ctx context.Context, repo github.Repository, runID int64, opts *github.RerunOpts) error {
+			return nil
+		},
+		TriggerWorkflowFunc: func(ctx context.Context, repo github.Repository, workflowFile, ref string, inputs map[string]interface{}) error {
+			return nil
+		},
+		DeleteRunFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return nil
+		},
+		DeleteRunLogsFunc: func(ctx context.Context, repo github.Repository, runID int64) error {
+			return nil
+		},
+		ListPendingDeploymentsFunc: func(ctx context.Context, repo github.Repository, runID int64) ([]github.PendingDeployment, error) {
+			return nil, nil
+		},
+		ReviewPendingDeploymentsFunc: func(ctx context.Context, repo github.Repository, runID int64, environmentIDs []int64, approve bool, comment string) error {
+			return nil
+		},
+		RateLimitRemainingFunc: func() int {
+			return 5000
+		},
+		RateLimitFunc: func() github.RateLimit {
+			return github.RateLimit{Limit: 5000, Remaining: 5000}
+		},
+	}
+}
+
+// runCLI runs args against the mock and returns the exit code and outputs
+func runCLI(mock *github.MockClient, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	env := &Env{Client: mock, Repo: testRepo, Stdout: &stdout, Stderr: &stderr}
+	code := Run(context.Background(), env, args)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Help(t *testing.T) {
+	code, stdout, _ := runCLI(newTestMock(), "help")
+	if code != ExitOK {
+		t.Errorf("exit code = %d, want %d", code, ExitOK)
+	}
+	for _, want := range []string{"workflows list", "runs list", "jobs list", "logs", "cancel", "rerun", "dispatch"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("help output missing %q", want)
+		}
+	}
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	code, _, stderr := runCLI(newTestMock(), "workflows", "delete")
+	if code != ExitUsage {
+		t.Errorf("exit code = %d, want %d", code, ExitUsage)
+	}
+	if !strings.Contains(stderr, "unknown command") {
+		t.Errorf("stderr = %q, want unknown command error", stderr)
+	}
+}
+
+func TestRun_UsageErrorPrintsUsage(t *testing.T) {
+	code, _, stderr := runCLI(newTestMock(), "jobs", "list")
+	if code != ExitUsage {
+		t.Errorf("exit code = %d, want %d", code, ExitUsage)
+	}
+	if !strings.Contains(stderr, "Usage: lazyactions jobs list") {
+		t.Errorf("stderr = %q, want usage line", stderr)
+	}
+}
+
+func TestRun_APIErrorExitCode(t *testing.T) {
+	mock := newTestMock()
+	mock.CancelRunFunc = func(ctx context.Context, repo github.Repository, runID int64) error {
+		return &github.AppError{Type: github.ErrTypeNotFound, Message: "Resource not found"}
+	}
+
+	code, stdout, stderr := runCLI(mock, "cancel", "42")
+	if code != ExitNotFound {
+		t.Errorf("exit code = %d, want %d", code, ExitNotFound)
+	}
+	if stdout != "" {
+		t.Errorf("stdout = %q, want empty", stdout)
+	}
+	if !strings.Contains(stderr, "Resource not found") {
+		t.Errorf("stderr = %q, want error message", stderr)
+	}
+}
+
+func TestIsCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"workflows", true},
+		{"runs", true},
+		{"jobs", true},
+		{"logs", true},
+		{"cancel", true},
+		{"rerun", true},
+		{"dispatch", true},
+		{"lint", true},
+		{"help", true},
+		{"/path/to/repo", false},
+		{"list", false},
+	}
+	for _, tt := range tests {
+		if got := IsCommand(tt.name); got != tt.want {
+			t.Errorf("IsCommand(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"usage", usagef("bad"), ExitUsage},
+		{"auth", &github.AppError{Type: github.ErrTypeAuth}, ExitAuth},
+		{"not found", &github.AppError{Type: github.ErrTypeNotFound}, ExitNotFound},
+		{"rate limit", &github.AppError{Type: github.ErrTypeRateLimit}, ExitRateLimit},
+		{"network", &github.AppError{Type: github.ErrTypeNetwork}, ExitNetwork},
+		{"server", &github.AppError{Type: github.ErrTypeServer}, ExitServer},
+		{"unknown app error", &github.AppError{Type: github.ErrTypeUnknown}, ExitFailure},
+		{"raw network error", syscall.ECONNREFUSED, ExitNetwork},
+		{"lint", fmt.Errorf("%w (3)", errLintIssues), ExitLint},
+		{"plain error", errors.New("boom"), ExitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseArgs_InterspersedFlags(t *testing.T) {
+	var stderr bytes.Buffer
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&stderr)
+	jsonOut := fs.Bool("json", false, "")
+
+	positional, err := parseArgs(fs, []string{"123", "--json"})
+	if err != nil {
+		t.Fatalf("parseArgs() error = %v", err)
+	}
+	if !*jsonOut {
+		t.Error("expected --json after positional argument to be parsed")
+	}
+	if len(positional) != 1 || positional[0] != "123" {
+		t.Errorf("positional = %v, want [123]", positional)
+	}
+}
+
+func TestParseArgs_UnknownFlag(t *testing.T) {
+	var stderr bytes.Buffer
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&stderr)
+
+	_, err := parseArgs(fs, []string{"--nope"})
+	if ExitCode(err) != ExitUsage {
+		t.Errorf("ExitCode(%v) = %d, want %d", err, ExitCode(err), ExitUsage)
+	}
+}
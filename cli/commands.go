@@ -0,0 +1,422 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// maxRetries is the number of retries for read-only API calls
+const maxRetries = 3
+
+// runWorkflowsList implements "workflows list"
+func runWorkflowsList(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("workflows list", env)
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usagef("unexpected argument %q", positional[0])
+	}
+
+	workflows, err := listWorkflows(ctx, env)
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		return writeJSON(env.Stdout, workflows)
+	}
+	return writeWorkflows(env.Stdout, workflows)
+}
+
+// runRunsList implements "runs list"
+func runRunsList(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("runs list", env)
+	workflow := fs.String("workflow", "", "workflow ID, file name or name")
+	opts := &github.ListRunsOpts{}
+	fs.StringVar(&opts.Branch, "branch", "", "only runs for this branch")
+	fs.StringVar(&opts.Event, "event", "", "only runs triggered by this event")
+	fs.StringVar(&opts.Status, "status", "", "only runs with this status or conclusion")
+	fs.IntVar(&opts.PerPage, "limit", 30, "maximum number of runs (1-100)")
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usagef("unexpected argument %q", positional[0])
+	}
+	if opts.PerPage < 1 || opts.PerPage > 100 {
+		return usagef("--limit must be between 1 and 100")
+	}
+
+	if *workflow != "" {
+		wf, err := resolveWorkflow(ctx, env, *workflow)
+		if err != nil {
+			return err
+		}
+		opts.WorkflowID = wf.ID
+	}
+
+	var runs []github.Run
+	err = github.RetryWithBackoff(ctx, maxRetries, func() error {
+		var err error
+		runs, err = env.Client.ListRuns(ctx, env.Repo, opts)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		return writeJSON(env.Stdout, runs)
+	}
+	return writeRuns(env.Stdout, runs)
+}
+
+// runJobsList implements "jobs list RUN_ID [--attempt N]"
+func runJobsList(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("jobs list", env)
+	jsonOut := fs.Bool("json", false, "output JSON")
+	attempt := fs.Int("attempt", 0, "list the jobs of this run attempt (default: latest)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	runID, err := parseIDArg(positional, "RUN_ID")
+	if err != nil {
+		return err
+	}
+	if *attempt < 0 {
+		return usagef("--attempt must be positive")
+	}
+
+	var jobs []github.Job
+	err = github.RetryWithBackoff(ctx, maxRetries, func() error {
+		var err error
+		if *attempt > 0 {
+			jobs, err = env.Client.ListJobsForAttempt(ctx, env.Repo, runID, *attempt)
+		} else {
+			jobs, err = env.Client.ListJobs(ctx, env.Repo, runID)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		return writeJSON(env.Stdout, jobs)
+	}
+	return writeJobs(env.Stdout, jobs)
+}
+
+// runLogs implements "logs JOB_ID"
+func runLogs(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("logs", env)
+	step := fs.String("step", "", "only print the step with this number (1-based) or name")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	jobID, err := parseIDArg(positional, "JOB_ID")
+	if err != nil {
+		return err
+	}
+
+	var logs string
+	err = github.RetryWithBackoff(ctx, maxRetries, func() error {
+		var err error
+		logs, err = env.Client.GetJobLogs(ctx, env.Repo, jobID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	logs = github.SanitizeLogs(logs)
+
+	if *step != "" {
+		logs, err = stepLogs(logs, *step)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(env.Stdout, logs)
+	if err == nil && logs != "" && !strings.HasSuffix(logs, "\n") {
+		_, err = fmt.Fprintln(env.Stdout)
+	}
+	return err
+}
+
+// runCancel implements "cancel RUN_ID [--force]"
+func runCancel(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("cancel", env)
+	force := fs.Bool("force", false, "force-cancel a run stuck while cancelling (skips always() steps)")
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	runID, err := parseIDArg(positional, "RUN_ID")
+	if err != nil {
+		return err
+	}
+
+	action, cancel := "cancel", env.Client.CancelRun
+	if *force {
+		action, cancel = "force_cancel", env.Client.ForceCancelRun
+	}
+	if err := cancel(ctx, env.Repo, runID); err != nil {
+		return err
+	}
+	if *jsonOut {
+		return writeJSON(env.Stdout, actionResult{Action: action, RunID: runID, OK: true})
+	}
+	if *force {
+		_, err = fmt.Fprintf(env.Stdout, "Force-cancel requested for run %d\n", runID)
+	} else {
+		_, err = fmt.Fprintf(env.Stdout, "Cancel requested for run %d\n", runID)
+	}
+	return err
+}
+
+// runRerun implements "rerun RUN_ID [--failed] [--debug]"
+func runRerun(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("rerun", env)
+	failed := fs.Bool("failed", false, "only rerun failed jobs")
+	debug := fs.Bool("debug", false, "enable debug logging for the rerun")
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	runID, err := parseIDArg(positional, "RUN_ID")
+	if err != nil {
+		return err
+	}
+
+	opts := &github.RerunOpts{EnableDebugLogging: *debug}
+	action := "rerun"
+	if *failed {
+		action = "rerun_failed"
+		err = env.Client.RerunFailedJobs(ctx, env.Repo, runID, opts)
+	} else {
+		err = env.Client.RerunWorkflow(ctx, env.Repo, runID, opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *jsonOut {
+		return writeJSON(env.Stdout, actionResult{Action: action, RunID: runID, Debug: *debug, OK: true})
+	}
+	if *failed {
+		_, err = fmt.Fprintf(env.Stdout, "Rerun of failed jobs requested for run %d%s\n", runID, debugNote(*debug))
+	} else {
+		_, err = fmt.Fprintf(env.Stdout, "Rerun requested for run %d%s\n", runID, debugNote(*debug))
+	}
+	return err
+}
+
+// runJobsRerun implements "jobs rerun JOB_ID [--debug]"
+func runJobsRerun(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("jobs rerun", env)
+	debug := fs.Bool("debug", false, "enable debug logging for the rerun")
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	jobID, err := parseIDArg(positional, "JOB_ID")
+	if err != nil {
+		return err
+	}
+
+	if err := env.Client.RerunJob(ctx, env.Repo, jobID, &github.RerunOpts{EnableDebugLogging: *debug}); err != nil {
+		return err
+	}
+	if *jsonOut {
+		return writeJSON(env.Stdout, actionResult{Action: "rerun_job", JobID: jobID, Debug: *debug, OK: true})
+	}
+	_, err = fmt.Fprintf(env.Stdout, "Rerun requested for job %d%s\n", jobID, debugNote(*debug))
+	return err
+}
+
+// debugNote describes a rerun with debug logging in command output
+func debugNote(debug bool) string {
+	if debug {
+		return " with debug logging"
+	}
+	return ""
+}
+
+// runDispatch implements "dispatch WORKFLOW"
+func runDispatch(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("dispatch", env)
+	ref := fs.String("ref", "", "branch or tag to run the workflow on (default: the repository's default branch)")
+	inputs := inputsFlag{}
+	fs.Var(inputs, "input", "workflow input as KEY=VALUE (repeatable)")
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usagef("expected exactly one WORKFLOW argument")
+	}
+
+	wf, err := resolveWorkflow(ctx, env, positional[0])
+	if err != nil {
+		return err
+	}
+	workflowFile := path.Base(wf.Path)
+	if *ref == "" {
+		if *ref, err = defaultBranch(ctx, env); err != nil {
+			return err
+		}
+	}
+
+	var inputsInterface map[string]interface{}
+	if len(inputs) > 0 {
+		inputsInterface = make(map[string]interface{}, len(inputs))
+		for k, v := range inputs {
+			inputsInterface[k] = v
+		}
+	}
+	if err := env.Client.TriggerWorkflow(ctx, env.Repo, workflowFile, *ref, inputsInterface); err != nil {
+		return err
+	}
+
+	if *jsonOut {
+		return writeJSON(env.Stdout, actionResult{
+			Action:   "dispatch",
+			Workflow: workflowFile,
+			Ref:      *ref,
+			Inputs:   inputs,
+			OK:       true,
+		})
+	}
+	_, err = fmt.Fprintf(env.Stdout, "Dispatched %s on %s\n", workflowFile, *ref)
+	return err
+}
+
+// defaultBranch gets the default branch of the repository, retrying transient failures
+func defaultBranch(ctx context.Context, env *Env) (string, error) {
+	var branch string
+	err := github.RetryWithBackoff(ctx, maxRetries, func() error {
+		var err error
+		branch, err = env.Client.GetDefaultBranch(ctx, env.Repo)
+		return err
+	})
+	return branch, err
+}
+
+// listWorkflows lists workflows, retrying transient failures
+func listWorkflows(ctx context.Context, env *Env) ([]github.Workflow, error) {
+	var workflows []github.Workflow
+	err := github.RetryWithBackoff(ctx, maxRetries, func() error {
+		var err error
+		workflows, err = env.Client.ListWorkflows(ctx, env.Repo)
+		return err
+	})
+	return workflows, err
+}
+
+// resolveWorkflow finds a workflow by ID, file name (ci.yml), path or name
+func resolveWorkflow(ctx context.Context, env *Env, ref string) (github.Workflow, error) {
+	workflows, err := listWorkflows(ctx, env)
+	if err != nil {
+		return github.Workflow{}, err
+	}
+
+	id, idErr := strconv.ParseInt(ref, 10, 64)
+	for _, wf := range workflows {
+		if idErr == nil && wf.ID == id {
+			return wf, nil
+		}
+		if wf.Path == ref || path.Base(wf.Path) == ref {
+			return wf, nil
+		}
+	}
+	for _, wf := range workflows {
+		if strings.EqualFold(wf.Name, ref) {
+			return wf, nil
+		}
+	}
+
+	return github.Workflow{}, &github.AppError{
+		Type:    github.ErrTypeNotFound,
+		Message: fmt.Sprintf("workflow %q not found", ref),
+	}
+}
+
+// stepLogs returns the logs of one step selected by 1-based number or name.
+// Names match case-insensitively, preferring an exact match over a substring.
+func stepLogs(logs, step string) (string, error) {
+	parsed := github.ParseLogs(logs)
+
+	if n, err := strconv.Atoi(step); err == nil {
+		if n < 1 || n > len(parsed.Steps) {
+			return "", &github.AppError{
+				Type:    github.ErrTypeNotFound,
+				Message: fmt.Sprintf("step %d not found (job has %d steps)", n, len(parsed.Steps)),
+			}
+		}
+		return parsed.GetStepLogs(n - 1), nil
+	}
+
+	match := -1
+	for i, s := range parsed.Steps {
+		if strings.EqualFold(s.Name, step) {
+			match = i
+			break
+		}
+		if match < 0 && strings.Contains(strings.ToLower(s.Name), strings.ToLower(step)) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return "", &github.AppError{
+			Type:    github.ErrTypeNotFound,
+			Message: fmt.Sprintf("step %q not found", step),
+		}
+	}
+	return parsed.GetStepLogs(match), nil
+}
+
+// parseIDArg parses the single positional ID argument of a command
+func parseIDArg(positional []string, name string) (int64, error) {
+	if len(positional) != 1 {
+		return 0, usagef("expected exactly one %s argument", name)
+	}
+	id, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil || id <= 0 {
+		return 0, usagef("invalid %s: %q", name, positional[0])
+	}
+	return id, nil
+}
+
+// inputsFlag collects repeated KEY=VALUE flags
+type inputsFlag map[string]string
+
+func (f inputsFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for k, v := range f {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f inputsFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected KEY=VALUE, got %q", value)
+	}
+	f[key] = val
+	return nil
+}
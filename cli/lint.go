@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nnnkkk7/lazyactions/lint"
+)
+
+var errLintIssues = errors.New("problems found in workflow files")
+
+// runLint implements "lint": check the workflow files of the local repository
+func runLint(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("lint", env)
+	jsonOut := fs.Bool("json", false, "output JSON")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usagef("unexpected argument %q", positional[0])
+	}
+	if env.RepoRoot == nil {
+		return usagef("lint must be run inside a git repository")
+	}
+
+	root, err := env.RepoRoot()
+	if err != nil {
+		return err
+	}
+	issues, err := lint.Dir(root)
+	if err != nil {
+		return err
+	}
+
+	if *jsonOut {
+		if issues == nil {
+			issues = []lint.Issue{}
+		}
+		if err := writeJSON(env.Stdout, issues); err != nil {
+			return err
+		}
+	} else {
+		for _, issue := range issues {
+			fmt.Fprintln(env.Stdout, issue)
+		}
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%w (%d)", errLintIssues, len(issues))
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/lazyactions/lint"
+)
+
+// runLintIn runs "lint" with args against a repository whose only workflow is content
+func runLintIn(t *testing.T, content string, args ...string) (int, string, string) {
+	t.Helper()
+	root := t.TempDir()
+	dir := filepath.Join(root, ".github", "workflows")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "ci.yml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &Env{
+		Stdout:   &stdout,
+		Stderr:   &stderr,
+		RepoRoot: func() (string, error) { return root, nil },
+	}
+	code := Run(context.Background(), env, append([]string{"lint"}, args...))
+	return code, stdout.String(), stderr.String()
+}
+
+const brokenWorkflow = `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    needs: test
+    steps:
+      - run: echo ${{ github.sha }
+`
+
+func TestLint_Clean(t *testing.T) {
+	code, stdout, stderr := runLintIn(t, "on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n    steps:\n      - run: make\n")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, want %d (stderr: %s)", code, ExitOK, stderr)
+	}
+	if stdout != "" {
+		t.Errorf("stdout = %q, want no output", stdout)
+	}
+}
+
+func TestLint_Problems(t *testing.T) {
+	code, stdout, stderr := runLintIn(t, brokenWorkflow)
+	if code != ExitLint {
+		t.Fatalf("exit code = %d, want %d", code, ExitLint)
+	}
+	want := `.github/workflows/ci.yml:5:12: job "build" needs unknown job "test" (needs)
+.github/workflows/ci.yml:7:19: unterminated expression: missing }} (expression)
+`
+	if stdout != want {
+		t.Errorf("stdout =\n%s\nwant\n%s", stdout, want)
+	}
+	if !strings.Contains(stderr, "problems found in workflow files (2)") {
+		t.Errorf("stderr = %q, want the number of problems", stderr)
+	}
+}
+
+func TestLint_JSON(t *testing.T) {
+	code, stdout, _ := runLintIn(t, brokenWorkflow, "--json")
+	if code != ExitLint {
+		t.Fatalf("exit code = %d, want %d", code, ExitLint)
+	}
+	var issues []lint.Issue
+	if err := json.Unmarshal([]byte(stdout), &issues); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout)
+	}
+	if len(issues) != 2 || issues[0].Rule != lint.RuleNeeds || issues[0].Line != 5 {
+		t.Errorf("issues = %+v", issues)
+	}
+
+	_, stdout, _ = runLintIn(t, "on: push\njobs:\n  build:\n    uses: ./.github/workflows/reusable.yml\n", "--json")
+	if strings.TrimSpace(stdout) != "[]" {
+		t.Errorf("stdout = %q, want an empty list", stdout)
+	}
+}
+
+func TestLint_OutsideRepository(t *testing.T) {
+	code, _, stderr := runCLI(newTestMock(), "lint")
+	if code != ExitUsage {
+		t.Errorf("exit code = %d, want %d (stderr: %s)", code, ExitUsage, stderr)
+	}
+}
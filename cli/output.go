@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// actionResult is the JSON output of commands that change state
+type actionResult struct {
+	Action   string            `json:"action"`
+	RunID    int64             `json:"run_id,omitempty"`
+	JobID    int64             `json:"job_id,omitempty"`
+	Debug    bool              `json:"debug,omitempty"`
+	Workflow string            `json:"workflow,omitempty"`
+	Ref      string            `json:"ref,omitempty"`
+	Inputs   map[string]string `json:"inputs,omitempty"`
+	OK       bool              `json:"ok"`
+}
+
+// writeJSON writes v as indented JSON followed by a newline
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// newTable returns a tab-aligned writer for table output.
+// Callers must Flush it.
+func newTable(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+}
+
+// writeWorkflows writes workflows as a table
+func writeWorkflows(w io.Writer, workflows []github.Workflow) error {
+	tw := newTable(w)
+	fmt.Fprintln(tw, "ID\tNAME\tSTATE\tPATH")
+	for _, wf := range workflows {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", wf.ID, wf.Name, wf.State, wf.Path)
+	}
+	return tw.Flush()
+}
+
+// writeRuns writes runs as a table
+func writeRuns(w io.Writer, runs []github.Run) error {
+	tw := newTable(w)
+	fmt.Fprintln(tw, "ID\tNUMBER\tSTATUS\tNAME\tBRANCH\tEVENT\tACTOR\tCREATED")
+	for _, r := range runs {
+		fmt.Fprintf(tw, "%d\t#%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.ID, r.RunNumber, statusText(r.Status, r.Conclusion), r.Name,
+			r.Branch, r.Event, r.Actor, formatTime(r.CreatedAt))
+	}
+	return tw.Flush()
+}
+
+// writeJobs writes jobs as a table
+func writeJobs(w io.Writer, jobs []github.Job) error {
+	tw := newTable(w)
+	fmt.Fprintln(tw, "ID\tSTATUS\tNAME")
+	for _, j := range jobs {
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", j.ID, statusText(j.Status, j.Conclusion), j.Name)
+	}
+	return tw.Flush()
+}
+
+// statusText returns the conclusion for completed items and the status otherwise
+func statusText(status, conclusion string) string {
+	if status == "completed" && conclusion != "" {
+		return conclusion
+	}
+	return status
+}
+
+// formatTime formats a timestamp for table output
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+func TestStatusText(t *testing.T) {
+	tests := []struct {
+		status, conclusion, want string
+	}{
+		{"completed", "success", "success"},
+		{"completed", "failure", "failure"},
+		{"completed", "", "completed"},
+		{"in_progress", "", "in_progress"},
+		{"queued", "", "queued"},
+	}
+	for _, tt := range tests {
+		if got := statusText(tt.status, tt.conclusion); got != tt.want {
+			t.Errorf("statusText(%q, %q) = %q, want %q", tt.status, tt.conclusion, got, tt.want)
+		}
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	if got := formatTime(time.Time{}); got != "-" {
+		t.Errorf("formatTime(zero) = %q, want -", got)
+	}
+	ts := time.Date(2024, 1, 15, 10, 30, 0, 0, time.Local)
+	if got := formatTime(ts); got != "2024-01-15 10:30" {
+		t.Errorf("formatTime() = %q, want 2024-01-15 10:30", got)
+	}
+}
+
+func TestWriteRuns_AlignsColumns(t *testing.T) {
+	var buf bytes.Buffer
+	runs := []github.Run{
+		{ID: 1, RunNumber: 1, Status: "queued", Name: "CI", Branch: "main"},
+		{ID: 22222, RunNumber: 2, Status: "completed", Conclusion: "success", Name: "CI", Branch: "feature/long"},
+	}
+	if err := writeRuns(&buf, runs); err != nil {
+		t.Fatalf("writeRuns() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines", len(lines))
+	}
+	col := strings.Index(lines[0], "NUMBER")
+	for _, line := range lines[1:] {
+		if !strings.HasPrefix(line[col:], "#") {
+			t.Errorf("column misaligned in %q", line)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// Watch defaults
+const (
+	defaultWatchInterval = 10 * time.Second
+	defaultWatchTimeout  = 30 * time.Minute
+	// maxErrorLines caps the error lines printed per failed job
+	maxErrorLines = 20
+)
+
+var (
+	errRunsFailed   = errors.New("not all runs succeeded")
+	errWatchTimeout = errors.New("timed out waiting for runs")
+)
+
+// watchConfig holds the options of a watch
+type watchConfig struct {
+	interval time.Duration
+	failFast bool
+}
+
+// runWatch implements "watch": wait for the runs of a commit and report their verdict
+func runWatch(ctx context.Context, env *Env, args []string) error {
+	fs := newFlagSet("watch", env)
+	sha := fs.String("sha", "", "commit to watch (default: local HEAD)")
+	workflow := fs.String("workflow", "", "only watch runs of this workflow ID, file name or name")
+	timeout := fs.Duration("timeout", defaultWatchTimeout, "give up after this long (0 waits forever)")
+	cfg := watchConfig{}
+	fs.DurationVar(&cfg.interval, "interval", defaultWatchInterval, "time between status checks")
+	fs.BoolVar(&cfg.failFast, "fail-fast", false, "stop as soon as any run fails")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usagef("unexpected argument %q", positional[0])
+	}
+	if cfg.interval <= 0 {
+		return usagef("--interval must be positive")
+	}
+
+	if *sha == "" {
+		if env.HeadSHA == nil {
+			return usagef("--sha is required outside a git repository")
+		}
+		if *sha, err = env.HeadSHA(); err != nil {
+			return err
+		}
+	}
+
+	opts := &github.ListRunsOpts{HeadSHA: *sha, PerPage: 100}
+	if *workflow != "" {
+		wf, err := resolveWorkflow(ctx, env, *workflow)
+		if err != nil {
+			return err
+		}
+		opts.WorkflowID = wf.ID
+	}
+
+	watchCtx := ctx
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		watchCtx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	fmt.Fprintf(env.Stdout, "Watching runs for %s\n", shortSHA(*sha))
+	runs, err := watchRuns(watchCtx, env, opts, cfg)
+	if err != nil {
+		// Only our own deadline is a timeout; an interrupted parent is reported as is
+		if errors.Is(watchCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			if len(runs) == 0 {
+				return fmt.Errorf("%w: no runs found for %s after %s", errWatchTimeout, shortSHA(*sha), *timeout)
+			}
+			return fmt.Errorf("%w after %s", errWatchTimeout, *timeout)
+		}
+		return err
+	}
+
+	failed := failedRuns(runs)
+	if len(failed) == 0 {
+		fmt.Fprintf(env.Stdout, "All %d runs succeeded\n", len(runs))
+		return nil
+	}
+	reportFailures(ctx, env, failed)
+	return fmt.Errorf("%w (%d of %d)", errRunsFailed, len(failed), len(runs))
+}
+
+// watchRuns polls the runs matching opts until all of them have completed.
+// Runs can appear after the first ones complete (e.g., workflow_run triggers),
+// so completion must be seen on two consecutive polls with the same runs.
+// On error the last seen runs are returned with it.
+func watchRuns(ctx context.Context, env *Env, opts *github.ListRunsOpts, cfg watchConfig) ([]github.Run, error) {
+	display := newProgress(env.Stdout)
+	var runs []github.Run
+	var settled []int64
+
+	for {
+		var latest []github.Run
+		err := github.RetryWithBackoff(ctx, maxRetries, func() error {
+			var err error
+			latest, err = env.Client.ListRuns(ctx, env.Repo, opts)
+			return err
+		})
+		if ctx.Err() != nil {
+			return runs, ctx.Err()
+		}
+		if err != nil {
+			return runs, err
+		}
+
+		runs = sortRuns(latest)
+		display.update(runs, time.Now())
+
+		if cfg.failFast && len(failedRuns(runs)) > 0 {
+			return runs, nil
+		}
+		if len(runs) > 0 && allCompleted(runs) {
+			ids := runIDs(runs)
+			if slices.Equal(ids, settled) {
+				return runs, nil
+			}
+			settled = ids
+		} else {
+			settled = nil
+		}
+
+		timer := time.NewTimer(cfg.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return runs, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reportFailures prints the failed jobs of each failed run with their error lines
+func reportFailures(ctx context.Context, env *Env, runs []github.Run) {
+	for _, r := range runs {
+		fmt.Fprintf(env.Stdout, "\n%s #%d %s %s\n", r.Name, r.RunNumber, statusText(r.Status, r.Conclusion), r.URL)
+
+		jobs, err := env.Client.ListJobs(ctx, env.Repo, r.ID)
+		if err != nil {
+			fmt.Fprintf(env.Stdout, "  (failed to list jobs: %v)\n", err)
+			continue
+		}
+		for _, j := range jobs {
+			if !j.IsCompleted() || j.Conclusion == "success" || j.Conclusion == "skipped" {
+				continue
+			}
+			fmt.Fprintf(env.Stdout, "  %s %s (%s)\n", runIcon(j.Status, j.Conclusion), j.Name, j.Conclusion)
+
+			logs, err := env.Client.GetJobLogs(ctx, env.Repo, j.ID)
+			if err != nil {
+				fmt.Fprintf(env.Stdout, "    (failed to get logs: %v)\n", err)
+				continue
+			}
+			for _, line := range errorLines(github.SanitizeLogs(logs), maxErrorLines) {
+				fmt.Fprintf(env.Stdout, "    %s\n", line)
+			}
+		}
+	}
+}
+
+// errorLines extracts up to max "##[error]" lines from job logs, without timestamps or markers
+func errorLines(logs string, max int) []string {
+	var lines []string
+	for _, line := range strings.Split(logs, "\n") {
+		_, msg, ok := strings.Cut(line, "##[error]")
+		if !ok {
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(msg))
+		if len(lines) == max {
+			break
+		}
+	}
+	return lines
+}
+
+// failedRuns returns the completed runs that did not succeed
+func failedRuns(runs []github.Run) []github.Run {
+	var failed []github.Run
+	for _, r := range runs {
+		if r.Status == "completed" && r.Conclusion != "success" {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// allCompleted returns true if every run has completed
+func allCompleted(runs []github.Run) bool {
+	for _, r := range runs {
+		if r.Status != "completed" {
+			return false
+		}
+	}
+	return true
+}
+
+// sortRuns returns the runs ordered by ID so the display is stable between polls
+func sortRuns(runs []github.Run) []github.Run {
+	sorted := slices.Clone(runs)
+	slices.SortFunc(sorted, func(a, b github.Run) int {
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
+
+// runIDs returns the IDs of runs in order
+func runIDs(runs []github.Run) []int64 {
+	ids := make([]int64, len(runs))
+	for i, r := range runs {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+// shortSHA abbreviates a commit SHA for display
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// progress renders the live watch display.
+// On a terminal the run list is redrawn in place; otherwise only state changes are printed.
+type progress struct {
+	w      io.Writer
+	live   bool
+	lines  int              // Lines drawn by the last live update
+	states map[int64]string // Last printed state per run
+}
+
+// newProgress creates a progress display writing to w
+func newProgress(w io.Writer) *progress {
+	return &progress{w: w, live: isTerminal(w), states: make(map[int64]string)}
+}
+
+// update renders the current state of runs
+func (p *progress) update(runs []github.Run, now time.Time) {
+	if !p.live {
+		for _, r := range runs {
+			state := statusText(r.Status, r.Conclusion)
+			if p.states[r.ID] == state {
+				continue
+			}
+			p.states[r.ID] = state
+			fmt.Fprintln(p.w, formatRunProgress(r, now))
+		}
+		return
+	}
+
+	if p.lines > 0 {
+		fmt.Fprintf(p.w, "\033[%dA", p.lines)
+	}
+	if len(runs) == 0 {
+		fmt.Fprint(p.w, "\r\033[2KWaiting for runs to start...\n")
+		p.lines = 1
+		return
+	}
+	for _, r := range runs {
+		fmt.Fprintf(p.w, "\r\033[2K%s\n", formatRunProgress(r, now))
+	}
+	p.lines = len(runs)
+}
+
+// formatRunProgress formats one run line of the watch display
+func formatRunProgress(r github.Run, now time.Time) string {
+	line := fmt.Sprintf("%s %s #%d %s", runIcon(r.Status, r.Conclusion), r.Name, r.RunNumber, statusText(r.Status, r.Conclusion))
+	if d := r.Duration(now); d > 0 {
+		line += " " + d.Round(time.Second).String()
+	}
+	return line
+}
+
+// runIcon returns a plain status icon matching the TUI
+func runIcon(status, conclusion string) string {
+	switch {
+	case status == "in_progress":
+		return "●"
+	case status == "queued" || status == "waiting" || status == "pending":
+		return "○"
+	case conclusion == "success":
+		return "✓"
+	case conclusion == "failure" || conclusion == "timed_out":
+		return "✗"
+	case conclusion == "cancelled":
+		return "⊘"
+	default:
+		return "-"
+	}
+}
+
+// isTerminal returns true if w is a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
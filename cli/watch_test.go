@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/lazyactions/github"
+)
+
+// runSequence returns a ListRuns func that yields each result in turn, repeating the last one
+func runSequence(results ...[]github.Run) func(context.Context, github.Repository, *github.ListRunsOpts) ([]github.Run, error) {
+	var mu sync.Mutex
+	i := 0
+	return func(ctx context.Context, repo github.Repository, opts *github.ListRunsOpts) ([]github.Run, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		r := results[i]
+		if i < len(results)-1 {
+			i++
+		}
+		return r, nil
+	}
+}
+
+func TestWatch_AllSucceed(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence(
+		nil, // runs have not been created yet
+		[]github.Run{{ID: 1, Name: "CI", Status: "in_progress"}},
+		[]github.Run{{ID: 1, Name: "CI", Status: "completed", Conclusion: "success"}},
+	)
+
+	code, stdout, stderr := runCLI(mock, "watch", "--sha", "abcdef1234567890", "--interval", "1ms")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, want %d (stderr: %s)", code, ExitOK, stderr)
+	}
+	if opts := mock.ListRunsCalls()[0].Opts; opts.HeadSHA != "abcdef1234567890" {
+		t.Errorf("HeadSHA = %q, want abcdef1234567890", opts.HeadSHA)
+	}
+	if !strings.Contains(stdout, "Watching runs for abcdef1") || !strings.Contains(stdout, "All 1 runs succeeded") {
+		t.Errorf("stdout = %q", stdout)
+	}
+}
+
+func TestWatch_WaitsForLateRuns(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence(
+		[]github.Run{{ID: 1, Name: "CI", Status: "completed", Conclusion: "success"}},
+		[]github.Run{
+			{ID: 1, Name: "CI", Status: "completed", Conclusion: "success"},
+			{ID: 2, Name: "Deploy", Status: "queued"},
+		},
+		[]github.Run{
+			{ID: 1, Name: "CI", Status: "completed", Conclusion: "success"},
+			{ID: 2, Name: "Deploy", Status: "completed", Conclusion: "failure"},
+		},
+	)
+
+	code, stdout, _ := runCLI(mock, "watch", "--sha", "abc", "--interval", "1ms")
+	if code != ExitRunFailed {
+		t.Fatalf("exit code = %d, want %d (stdout: %s)", code, ExitRunFailed, stdout)
+	}
+	if !strings.Contains(stdout, "Deploy") {
+		t.Errorf("stdout = %q, want the late Deploy run", stdout)
+	}
+}
+
+func TestWatch_ReportsFailedJobErrors(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence([]github.Run{{ID: 1, Name: "CI", RunNumber: 3, Status: "completed", Conclusion: "failure"}})
+	mock.ListJobsFunc = func(ctx context.Context, repo github.Repository, runID int64) ([]github.Job, error) {
+		return []github.Job{
+			{ID: 10, Name: "lint", Status: "completed", Conclusion: "success"},
+			{ID: 11, Name: "test", Status: "completed", Conclusion: "failure"},
+		}, nil
+	}
+	mock.GetJobLogsFunc = func(ctx context.Context, repo github.Repository, jobID int64) (string, error) {
+		return "2024-01-15T10:30:00.0000000Z ok\n2024-01-15T10:30:01.0000000Z ##[error]Process completed with exit code 1.", nil
+	}
+
+	code, stdout, stderr := runCLI(mock, "watch", "--sha", "abc", "--interval", "1ms")
+	if code != ExitRunFailed {
+		t.Fatalf("exit code = %d, want %d", code, ExitRunFailed)
+	}
+	if !strings.Contains(stdout, "test (failure)") || strings.Contains(stdout, "lint (") {
+		t.Errorf("stdout = %q, want only the failed job", stdout)
+	}
+	if !strings.Contains(stdout, "    Process completed with exit code 1.") {
+		t.Errorf("stdout = %q, want error line", stdout)
+	}
+	if calls := mock.GetJobLogsCalls(); len(calls) != 1 || calls[0].JobID != 11 {
+		t.Errorf("GetJobLogs calls = %v, want job 11 only", calls)
+	}
+	if !strings.Contains(stderr, "not all runs succeeded (1 of 1)") {
+		t.Errorf("stderr = %q", stderr)
+	}
+}
+
+func TestWatch_FailFast(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence([]github.Run{
+		{ID: 1, Name: "CI", Status: "completed", Conclusion: "failure"},
+		{ID: 2, Name: "Deploy", Status: "in_progress"},
+	})
+
+	code, _, _ := runCLI(mock, "watch", "--sha", "abc", "--interval", "1ms", "--fail-fast")
+	if code != ExitRunFailed {
+		t.Fatalf("exit code = %d, want %d", code, ExitRunFailed)
+	}
+	if n := len(mock.ListRunsCalls()); n != 1 {
+		t.Errorf("ListRuns called %d times, want 1", n)
+	}
+}
+
+func TestWatch_Timeout(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence(nil)
+
+	code, _, stderr := runCLI(mock, "watch", "--sha", "abc", "--interval", "1ms", "--timeout", "20ms")
+	if code != ExitTimeout {
+		t.Fatalf("exit code = %d, want %d", code, ExitTimeout)
+	}
+	if !strings.Contains(stderr, "no runs found for abc") {
+		t.Errorf("stderr = %q", stderr)
+	}
+}
+
+func TestWatch_WorkflowFilter(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence([]github.Run{{ID: 1, Status: "completed", Conclusion: "success"}})
+
+	code, _, _ := runCLI(mock, "watch", "--sha", "abc", "--interval", "1ms", "--workflow", "deploy.yml")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, want %d", code, ExitOK)
+	}
+	if opts := mock.ListRunsCalls()[0].Opts; opts.WorkflowID != 2 {
+		t.Errorf("WorkflowID = %d, want 2", opts.WorkflowID)
+	}
+}
+
+func TestWatch_UsesLocalHead(t *testing.T) {
+	mock := newTestMock()
+	mock.ListRunsFunc = runSequence([]github.Run{{ID: 1, Status: "completed", Conclusion: "success"}})
+	env := &Env{
+		Client:  mock,
+		Repo:    testRepo,
+		Stdout:  &strings.Builder{},
+		Stderr:  &strings.Builder{},
+		HeadSHA: func() (string, error) { return "feedface", nil },
+	}
+
+	if code := Run(context.Background(), env, []string{"watch", "--interval", "1ms"}); code != ExitOK {
+		t.Fatalf("exit code = %d, want %d", code, ExitOK)
+	}
+	if opts := mock.ListRunsCalls()[0].Opts; opts.HeadSHA != "feedface" {
+		t.Errorf("HeadSHA = %q, want feedface", opts.HeadSHA)
+	}
+}
+
+func TestWatch_HeadSHAError(t *testing.T) {
+	env := &Env{
+		Client:  newTestMock(),
+		Repo:    testRepo,
+		Stdout:  &strings.Builder{},
+		Stderr:  &strings.Builder{},
+		HeadSHA: func() (string, error) { return "", errors.New("not a git repository") },
+	}
+	if code := Run(context.Background(), env, []string{"watch"}); code != ExitFailure {
+		t.Errorf("exit code = %d, want %d", code, ExitFailure)
+	}
+}
+
+func TestProgress_PrintsOnlyChanges(t *testing.T) {
+	var out strings.Builder
+	p := newProgress(&out)
+	now := time.Now()
+
+	running := []github.Run{{ID: 1, Name: "CI", RunNumber: 5, Status: "in_progress"}}
+	p.update(running, now)
+	p.update(running, now)
+	p.update([]github.Run{{ID: 1, Name: "CI", RunNumber: 5, Status: "completed", Conclusion: "success"}}, now)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %q", len(lines), out.String())
+	}
+	if lines[0] != "● CI #5 in_progress" || lines[1] != "✓ CI #5 success" {
+		t.Errorf("lines = %q", lines)
+	}
+}
+
+func TestErrorLines(t *testing.T) {
+	logs := "a\n##[error]first\nb\n2024-01-15T10:30:00Z ##[error]second\n##[error]third"
+	got := errorLines(logs, 2)
+	if len(got) != 2 || got[0] != "first" || got[1] != "second" {
+		t.Errorf("errorLines() = %q, want [first second]", got)
+	}
+}
@@ -2,37 +2,83 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
 
 	"github.com/nnnkkk7/lazyactions/app"
 	"github.com/nnnkkk7/lazyactions/auth"
+	"github.com/nnnkkk7/lazyactions/cli"
 	"github.com/nnnkkk7/lazyactions/github"
+	"github.com/nnnkkk7/lazyactions/notify"
 	"github.com/nnnkkk7/lazyactions/repo"
 )
 
 func main() {
-	if err := run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
-	}
+	os.Exit(run())
 }
 
-func run() error {
+func run() int {
+	// Parse HTTP settings
+	httpConfig := github.DefaultHTTPConfig()
+	flag.DurationVar(&httpConfig.ConnectTimeout, "connect-timeout", httpConfig.ConnectTimeout, "timeout for connecting to GitHub (0 disables)")
+	flag.DurationVar(&httpConfig.ReadTimeout, "read-timeout", httpConfig.ReadTimeout, "timeout waiting for a response (0 disables)")
+	flag.DurationVar(&httpConfig.Timeout, "timeout", httpConfig.Timeout, "overall timeout per request, including log downloads (0 disables)")
+	flag.StringVar(&httpConfig.ProxyURL, "proxy", "", "proxy URL (overrides HTTP_PROXY/HTTPS_PROXY)")
+	flag.StringVar(&httpConfig.CAFile, "ca-file", "", "PEM bundle of extra CA certificates to trust")
+	forceCancelAfter := flag.Duration("force-cancel-after", app.DefaultForceCancelAfter, "how long a cancelled run may keep running before force-cancel is offered")
+	costRates := github.DefaultCostRates()
+	flag.Float64Var(&costRates.PerMinute, "minute-rate", costRates.PerMinute, "USD per billable minute, used to estimate costs")
+	statsRuns := flag.Int("stats-runs", app.DefaultStatsRuns, fmt.Sprintf("recent runs workflow statistics are computed from (max %d)", app.MaxStatsRuns))
+	multiplierSpec := flag.String("os-multipliers", "", "minute-rate multipliers by runner OS (e.g., MACOS=10,WINDOWS=2)")
+	notifySpec := flag.String("notify", "bell", "notifiers for watched runs: "+strings.Join(notify.Names, ", ")+" (comma-separated)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: lazyactions [flags] [command]")
+		fmt.Fprintln(flag.CommandLine.Output(), "Run 'lazyactions help' for the list of commands.")
+		fmt.Fprintln(flag.CommandLine.Output())
+		fmt.Fprintln(flag.CommandLine.Output(), "Flags:")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) > 0 && !cli.IsCommand(args[0]) {
+		fmt.Fprintf(os.Stderr, "Error: unknown command %q\n", args[0])
+		flag.Usage()
+		return cli.ExitUsage
+	}
+	if len(args) > 0 && args[0] == "help" {
+		return cli.Run(context.Background(), &cli.Env{Stdout: os.Stdout, Stderr: os.Stderr}, args)
+	}
+	// Linting only reads local files, so it needs neither a token nor GitHub
+	if len(args) > 0 && args[0] == "lint" {
+		env := &cli.Env{Stdout: os.Stdout, Stderr: os.Stderr, RepoRoot: repo.Checkout{}.Root}
+		return cli.Run(context.Background(), env, args)
+	}
+
 	// Detect repository from current directory
 	repoInfo, err := repo.Detect()
 	if err != nil {
-		return fmt.Errorf("failed to detect repository: %w", err)
+		fmt.Fprintf(os.Stderr, "Error: failed to detect repository: %v\n", err)
+		return cli.ExitFailure
 	}
 
 	// Get authentication token (gh CLI -> GITHUB_TOKEN)
 	token, err := auth.GetToken()
 	if err != nil {
-		return fmt.Errorf("failed to get authentication: %w", err)
+		fmt.Fprintf(os.Stderr, "Error: failed to get authentication: %v\n", err)
+		return cli.ExitAuth
 	}
 
 	// Create GitHub client
-	client := github.NewClient(token.Value(), repoInfo.Owner, repoInfo.Name)
+	client, err := github.NewClientWithConfig(token.Value(), repoInfo.Owner, repoInfo.Name, httpConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to create GitHub client: %v\n", err)
+		return cli.ExitUsage
+	}
 
 	// Create repository struct
 	repository := github.Repository{
@@ -40,6 +86,52 @@ func run() error {
 		Name:  repoInfo.Name,
 	}
 
+	// Run a subcommand non-interactively
+	if len(args) > 0 {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		env := &cli.Env{
+			Client:  client,
+			Repo:    repository,
+			Stdout:  os.Stdout,
+			Stderr:  os.Stderr,
+			HeadSHA: repo.HeadSHA,
+		}
+		return cli.Run(ctx, env, args)
+	}
+
+	// Notifications for watched runs; terminal notifiers write through the TUI
+	terminal := app.NewTerminalWriter()
+	notifier, err := notify.New(*notifySpec, terminal)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return cli.ExitUsage
+	}
+	multipliers, err := github.ParseMultipliers(*multiplierSpec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return cli.ExitUsage
+	}
+	for runnerOS, m := range multipliers {
+		costRates.Multipliers[runnerOS] = m
+	}
+	opts := []app.Option{
+		app.WithNotifier(notifier),
+		app.WithTerminalWriter(terminal),
+		app.WithForceCancelAfter(*forceCancelAfter),
+		app.WithCostRates(costRates),
+		app.WithStatsRuns(*statsRuns),
+		app.WithLocalRepo(repo.Checkout{}),
+	}
+	// Branch watching needs the local branch; there is none on a detached HEAD
+	if branch, err := repo.CurrentBranch(); err == nil {
+		opts = append(opts, app.WithBranch(branch))
+	}
+
 	// Run TUI
-	return app.Run(client, repository)
+	if err := app.Run(client, repository, opts...); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return cli.ExitFailure
+	}
+	return cli.ExitOK
 }
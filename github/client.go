@@ -2,53 +2,117 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v68/github"
 )
 
 // realClient implements the Client interface using go-github
 type realClient struct {
-	client    *github.Client
-	owner     string
-	repoName  string
-	rateLimit int
+	client   *github.Client
+	download *http.Client // Unauthenticated client for signed log URLs
+	owner    string
+	repoName string
+
+	mu        sync.RWMutex
+	rateLimit RateLimit
 }
 
-// NewClient creates a new GitHub API client
+// NewClient creates a new GitHub API client with the default HTTP configuration
 func NewClient(token, owner, repoName string) Client {
-	httpClient := &http.Client{}
+	// The default configuration has no proxy or CA file, so it cannot fail
+	client, _ := NewClientWithConfig(token, owner, repoName, DefaultHTTPConfig())
+	return client
+}
+
+// NewClientWithConfig creates a new GitHub API client using the given HTTP configuration.
+// API calls and log downloads share one transport.
+func NewClientWithConfig(token, owner, repoName string, cfg HTTPConfig) (Client, error) {
+	transport, err := NewTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiTransport http.RoundTripper = transport
 	if token != "" {
-		httpClient = &http.Client{
-			Transport: &tokenTransport{token: token},
-		}
+		apiTransport = &tokenTransport{token: token, base: transport}
 	}
 
 	return &realClient{
-		client:    github.NewClient(httpClient),
-		owner:     owner,
-		repoName:  repoName,
-		rateLimit: 5000, // Default rate limit
-	}
+		client: github.NewClient(&http.Client{
+			Transport: apiTransport,
+			Timeout:   cfg.Timeout,
+		}),
+		download: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		},
+		owner:    owner,
+		repoName: repoName,
+		rateLimit: RateLimit{
+			Limit:     5000, // Default rate limit
+			Remaining: 5000,
+			Resource:  "core",
+		},
+	}, nil
 }
 
-// tokenTransport adds authorization header to requests
-type tokenTransport struct {
-	token string
+// updateRateLimit updates the rate limit from the response.
+// Responses without rate limit headers leave the last known state untouched.
+func (c *realClient) updateRateLimit(resp *github.Response) {
+	if resp == nil || resp.Rate.Limit == 0 {
+		return
+	}
+
+	rate := RateLimit{
+		Limit:     resp.Rate.Limit,
+		Remaining: resp.Rate.Remaining,
+		Reset:     resp.Rate.Reset.Time,
+		Resource:  "core",
+	}
+	if resp.Response != nil {
+		if resource := resp.Header.Get("X-RateLimit-Resource"); resource != "" {
+			rate.Resource = resource
+		}
+	}
+
+	c.mu.Lock()
+	c.rateLimit = rate
+	c.mu.Unlock()
 }
 
-func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Bearer "+t.token)
-	return http.DefaultTransport.RoundTrip(req)
+// GetDefaultBranch gets the default branch of a repository (e.g., "main").
+func (c *realClient) GetDefaultBranch(ctx context.Context, repo Repository) (string, error) {
+	r, resp, err := c.client.Repositories.Get(ctx, repo.Owner, repo.Name)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return "", WrapAPIError(err)
+	}
+	return r.GetDefaultBranch(), nil
 }
 
-// updateRateLimit updates the rate limit from the response.
-func (c *realClient) updateRateLimit(resp *github.Response) {
-	if resp != nil {
-		c.rateLimit = resp.Rate.Remaining
+// GetFileContent gets the content of a file at ref (a branch, tag, or commit SHA).
+// An empty ref reads the default branch.
+func (c *realClient) GetFileContent(ctx context.Context, repo Repository, path, ref string) (string, error) {
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	file, _, resp, err := c.client.Repositories.GetContents(ctx, repo.Owner, repo.Name, path, opts)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return "", WrapAPIError(err)
+	}
+	if file == nil {
+		return "", fmt.Errorf("%s is a directory", path)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", fmt.Errorf("failed to decode %s: %w", path, err)
 	}
+	return content, nil
 }
 
 // ListWorkflows lists all workflows in the repository.
@@ -72,6 +136,42 @@ func (c *realClient) ListWorkflows(ctx context.Context, repo Repository) ([]Work
 	return result, nil
 }
 
+// EnableWorkflow enables a disabled workflow.
+func (c *realClient) EnableWorkflow(ctx context.Context, repo Repository, workflowID int64) error {
+	resp, err := c.client.Actions.EnableWorkflowByID(ctx, repo.Owner, repo.Name, workflowID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	return nil
+}
+
+// DisableWorkflow disables a workflow so that it is no longer triggered.
+func (c *realClient) DisableWorkflow(ctx context.Context, repo Repository, workflowID int64) error {
+	resp, err := c.client.Actions.DisableWorkflowByID(ctx, repo.Owner, repo.Name, workflowID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	return nil
+}
+
+// GetWorkflowUsage gets the billable time of a workflow in the current billing cycle.
+func (c *realClient) GetWorkflowUsage(ctx context.Context, repo Repository, workflowID int64) (*Usage, error) {
+	usage, resp, err := c.client.Actions.GetWorkflowUsageByID(ctx, repo.Owner, repo.Name, workflowID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+	result := &Usage{Billable: make(map[string]time.Duration)}
+	if usage.Billable != nil {
+		for os, bill := range *usage.Billable {
+			result.Billable[os] = time.Duration(bill.GetTotalMS()) * time.Millisecond
+		}
+	}
+	return result, nil
+}
+
 // ListRuns lists workflow runs.
 func (c *realClient) ListRuns(ctx context.Context, repo Repository, opts *ListRunsOpts) ([]Run, error) {
 	ghOpts := &github.ListWorkflowRunsOptions{
@@ -81,6 +181,9 @@ func (c *realClient) ListRuns(ctx context.Context, repo Repository, opts *ListRu
 		if opts.PerPage > 0 {
 			ghOpts.ListOptions.PerPage = opts.PerPage
 		}
+		if opts.Page > 0 {
+			ghOpts.ListOptions.Page = opts.Page
+		}
 		if opts.Branch != "" {
 			ghOpts.Branch = opts.Branch
 		}
@@ -90,6 +193,9 @@ func (c *realClient) ListRuns(ctx context.Context, repo Repository, opts *ListRu
 		if opts.Event != "" {
 			ghOpts.Event = opts.Event
 		}
+		if opts.HeadSHA != "" {
+			ghOpts.HeadSHA = opts.HeadSHA
+		}
 		if opts.WorkflowID > 0 {
 			runs, resp, err := c.client.Actions.ListWorkflowRunsByID(ctx, repo.Owner, repo.Name, opts.WorkflowID, ghOpts)
 			c.updateRateLimit(resp)
@@ -108,6 +214,47 @@ func (c *realClient) ListRuns(ctx context.Context, repo Repository, opts *ListRu
 	return convertRuns(runs.WorkflowRuns), nil
 }
 
+// GetRun gets a single workflow run.
+func (c *realClient) GetRun(ctx context.Context, repo Repository, runID int64) (*Run, error) {
+	run, resp, err := c.client.Actions.GetWorkflowRunByID(ctx, repo.Owner, repo.Name, runID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+	runs := convertRuns([]*github.WorkflowRun{run})
+	return &runs[0], nil
+}
+
+// GetRunAttempt gets a specific attempt of a workflow run.
+func (c *realClient) GetRunAttempt(ctx context.Context, repo Repository, runID int64, attempt int) (*Run, error) {
+	run, resp, err := c.client.Actions.GetWorkflowRunAttempt(ctx, repo.Owner, repo.Name, runID, attempt, nil)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+	runs := convertRuns([]*github.WorkflowRun{run})
+	return &runs[0], nil
+}
+
+// GetRunUsage gets the billable time of a workflow run.
+func (c *realClient) GetRunUsage(ctx context.Context, repo Repository, runID int64) (*Usage, error) {
+	usage, resp, err := c.client.Actions.GetWorkflowRunUsageByID(ctx, repo.Owner, repo.Name, runID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+	result := &Usage{
+		Billable:    make(map[string]time.Duration),
+		RunDuration: time.Duration(usage.GetRunDurationMS()) * time.Millisecond,
+	}
+	if usage.Billable != nil {
+		for os, bill := range *usage.Billable {
+			result.Billable[os] = time.Duration(bill.GetTotalMS()) * time.Millisecond
+		}
+	}
+	return result, nil
+}
+
 // CancelRun cancels a workflow run.
 func (c *realClient) CancelRun(ctx context.Context, repo Repository, runID int64) error {
 	resp, err := c.client.Actions.CancelWorkflowRunByID(ctx, repo.Owner, repo.Name, runID)
@@ -118,19 +265,56 @@ func (c *realClient) CancelRun(ctx context.Context, repo Repository, runID int64
 	return nil
 }
 
-// RerunWorkflow reruns a workflow.
-func (c *realClient) RerunWorkflow(ctx context.Context, repo Repository, runID int64) error {
-	resp, err := c.client.Actions.RerunWorkflowByID(ctx, repo.Owner, repo.Name, runID)
-	c.updateRateLimit(resp)
+// ForceCancelRun cancels a run that does not respond to CancelRun,
+// for example because of always() steps. Cleanup steps are skipped.
+// go-github does not expose the endpoint, so the request is built here.
+func (c *realClient) ForceCancelRun(ctx context.Context, repo Repository, runID int64) error {
+	req, err := c.client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%v/%v/actions/runs/%v/force-cancel", repo.Owner, repo.Name, runID), nil)
 	if err != nil {
 		return WrapAPIError(err)
 	}
+	resp, err := c.client.Do(ctx, req, nil)
+	c.updateRateLimit(resp)
+	// GitHub answers 202 Accepted, which go-github reports as an error
+	var accepted *github.AcceptedError
+	if err != nil && !errors.As(err, &accepted) {
+		return WrapAPIError(err)
+	}
 	return nil
 }
 
+// RerunWorkflow reruns a workflow.
+func (c *realClient) RerunWorkflow(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
+	return c.rerun(ctx, fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun", repo.Owner, repo.Name, runID), opts)
+}
+
 // RerunFailedJobs reruns only failed jobs in a workflow.
-func (c *realClient) RerunFailedJobs(ctx context.Context, repo Repository, runID int64) error {
-	resp, err := c.client.Actions.RerunFailedJobsByID(ctx, repo.Owner, repo.Name, runID)
+func (c *realClient) RerunFailedJobs(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
+	return c.rerun(ctx, fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun-failed-jobs", repo.Owner, repo.Name, runID), opts)
+}
+
+// RerunJob reruns a single job and the jobs that depend on it.
+func (c *realClient) RerunJob(ctx context.Context, repo Repository, jobID int64, opts *RerunOpts) error {
+	return c.rerun(ctx, fmt.Sprintf("repos/%v/%v/actions/jobs/%v/rerun", repo.Owner, repo.Name, jobID), opts)
+}
+
+// rerunRequest is the body of the rerun endpoints.
+// go-github does not expose enable_debug_logging, so the requests are built here.
+type rerunRequest struct {
+	EnableDebugLogging bool `json:"enable_debug_logging,omitempty"`
+}
+
+// rerun posts a rerun request to path
+func (c *realClient) rerun(ctx context.Context, path string, opts *RerunOpts) error {
+	var body any // A nil *rerunRequest would be sent as "null"
+	if opts != nil && opts.EnableDebugLogging {
+		body = &rerunRequest{EnableDebugLogging: true}
+	}
+	req, err := c.client.NewRequest(http.MethodPost, path, body)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	resp, err := c.client.Do(ctx, req, nil)
 	c.updateRateLimit(resp)
 	if err != nil {
 		return WrapAPIError(err)
@@ -152,6 +336,66 @@ func (c *realClient) TriggerWorkflow(ctx context.Context, repo Repository, workf
 	return nil
 }
 
+// DeleteRun deletes a workflow run and its logs.
+func (c *realClient) DeleteRun(ctx context.Context, repo Repository, runID int64) error {
+	resp, err := c.client.Actions.DeleteWorkflowRun(ctx, repo.Owner, repo.Name, runID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	return nil
+}
+
+// DeleteRunLogs deletes all logs of a workflow run, keeping the run itself.
+func (c *realClient) DeleteRunLogs(ctx context.Context, repo Repository, runID int64) error {
+	resp, err := c.client.Actions.DeleteWorkflowRunLogs(ctx, repo.Owner, repo.Name, runID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	return nil
+}
+
+// ListPendingDeployments lists the deployments of a run waiting for approval.
+func (c *realClient) ListPendingDeployments(ctx context.Context, repo Repository, runID int64) ([]PendingDeployment, error) {
+	pending, resp, err := c.client.Actions.GetPendingDeployments(ctx, repo.Owner, repo.Name, runID)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+
+	result := make([]PendingDeployment, 0, len(pending))
+	for _, p := range pending {
+		env := p.GetEnvironment()
+		result = append(result, PendingDeployment{
+			EnvironmentID: env.GetID(),
+			Environment:   env.GetName(),
+			URL:           env.GetHTMLURL(),
+			CanApprove:    p.GetCurrentUserCanApprove(),
+		})
+	}
+	return result, nil
+}
+
+// ReviewPendingDeployments approves or rejects pending deployments of a run.
+func (c *realClient) ReviewPendingDeployments(ctx context.Context, repo Repository, runID int64, environmentIDs []int64, approve bool, comment string) error {
+	state := "rejected"
+	if approve {
+		state = "approved"
+	}
+	req := &github.PendingDeploymentsRequest{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}
+	_, resp, err := c.client.Actions.PendingDeployments(ctx, repo.Owner, repo.Name, runID, req)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return WrapAPIError(err)
+	}
+	return nil
+}
+
 // ListJobs lists jobs for a workflow run.
 func (c *realClient) ListJobs(ctx context.Context, repo Repository, runID int64) ([]Job, error) {
 	opts := &github.ListWorkflowJobsOptions{
@@ -162,7 +406,23 @@ func (c *realClient) ListJobs(ctx context.Context, repo Repository, runID int64)
 	if err != nil {
 		return nil, WrapAPIError(err)
 	}
+	return convertJobs(jobs), nil
+}
 
+// ListJobsForAttempt lists jobs for a specific attempt of a workflow run.
+// ListJobs only returns the jobs of the latest attempt.
+func (c *realClient) ListJobsForAttempt(ctx context.Context, repo Repository, runID int64, attempt int) ([]Job, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	jobs, resp, err := c.client.Actions.ListWorkflowJobsAttempt(ctx, repo.Owner, repo.Name, runID, int64(attempt), opts)
+	c.updateRateLimit(resp)
+	if err != nil {
+		return nil, WrapAPIError(err)
+	}
+	return convertJobs(jobs), nil
+}
+
+// convertJobs converts GitHub API jobs to our Job type.
+func convertJobs(jobs *github.Jobs) []Job {
 	result := make([]Job, 0, len(jobs.Jobs))
 	for _, j := range jobs.Jobs {
 		steps := make([]Step, 0, len(j.Steps))
@@ -179,10 +439,12 @@ func (c *realClient) ListJobs(ctx context.Context, repo Repository, runID int64)
 			Name:       j.GetName(),
 			Status:     j.GetStatus(),
 			Conclusion: j.GetConclusion(),
+			Attempt:    int(j.GetRunAttempt()),
+			URL:        j.GetHTMLURL(),
 			Steps:      steps,
 		})
 	}
-	return result, nil
+	return result
 }
 
 // GetJobLogs gets logs for a job.
@@ -193,15 +455,24 @@ func (c *realClient) GetJobLogs(ctx context.Context, repo Repository, jobID int6
 		return "", WrapAPIError(err)
 	}
 
-	logResp, err := http.Get(url.String())
+	// The signed URL must not receive the API token, so use the unauthenticated client
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create log request: %w", err)
+	}
+	logResp, err := c.download.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to download logs: %w", err)
+		return "", WrapAPIError(fmt.Errorf("failed to download logs: %w", err))
 	}
 	defer func() { _ = logResp.Body.Close() }()
 
+	if logResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download logs: %s", logResp.Status)
+	}
+
 	body, err := io.ReadAll(logResp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read logs: %w", err)
+		return "", WrapAPIError(fmt.Errorf("failed to read logs: %w", err))
 	}
 
 	return string(body), nil
@@ -209,6 +480,15 @@ func (c *realClient) GetJobLogs(ctx context.Context, repo Repository, jobID int6
 
 // RateLimitRemaining returns the remaining rate limit.
 func (c *realClient) RateLimitRemaining() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rateLimit.Remaining
+}
+
+// RateLimit returns the last known rate limit state.
+func (c *realClient) RateLimit() RateLimit {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.rateLimit
 }
 
@@ -216,17 +496,25 @@ func (c *realClient) RateLimitRemaining() int {
 func convertRuns(ghRuns []*github.WorkflowRun) []Run {
 	result := make([]Run, 0, len(ghRuns))
 	for _, r := range ghRuns {
+		var pulls []int
+		for _, pr := range r.PullRequests {
+			pulls = append(pulls, pr.GetNumber())
+		}
 		result = append(result, Run{
-			ID:         r.GetID(),
-			RunNumber:  r.GetRunNumber(),
-			Name:       r.GetName(),
-			Status:     r.GetStatus(),
-			Conclusion: r.GetConclusion(),
-			Branch:     r.GetHeadBranch(),
-			Event:      r.GetEvent(),
-			Actor:      r.GetActor().GetLogin(),
-			URL:        r.GetHTMLURL(),
-			CreatedAt:  r.GetCreatedAt().Time,
+			ID:           r.GetID(),
+			RunNumber:    r.GetRunNumber(),
+			Attempt:      r.GetRunAttempt(),
+			Name:         r.GetName(),
+			Status:       r.GetStatus(),
+			Conclusion:   r.GetConclusion(),
+			Branch:       r.GetHeadBranch(),
+			HeadSHA:      r.GetHeadSHA(),
+			Event:        r.GetEvent(),
+			Actor:        r.GetActor().GetLogin(),
+			URL:          r.GetHTMLURL(),
+			CreatedAt:    r.GetCreatedAt().Time,
+			UpdatedAt:    r.GetUpdatedAt().Time,
+			PullRequests: pulls,
 		})
 	}
 	return result
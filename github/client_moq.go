@@ -21,27 +21,75 @@ var _ Client = &MockClient{}
 //			CancelRunFunc: func(ctx context.Context, repo Repository, runID int64) error {
 //				panic("mock out the CancelRun method")
 //			},
+//			DeleteRunFunc: func(ctx context.Context, repo Repository, runID int64) error {
+//				panic("mock out the DeleteRun method")
+//			},
+//			DeleteRunLogsFunc: func(ctx context.Context, repo Repository, runID int64) error {
+//				panic("mock out the DeleteRunLogs method")
+//			},
+//			DisableWorkflowFunc: func(ctx context.Context, repo Repository, workflowID int64) error {
+//				panic("mock out the DisableWorkflow method")
+//			},
+//			EnableWorkflowFunc: func(ctx context.Context, repo Repository, workflowID int64) error {
+//				panic("mock out the EnableWorkflow method")
+//			},
+//			ForceCancelRunFunc: func(ctx context.Context, repo Repository, runID int64) error {
+//				panic("mock out the ForceCancelRun method")
+//			},
+//			GetDefaultBranchFunc: func(ctx context.Context, repo Repository) (string, error) {
+//				panic("mock out the GetDefaultBranch method")
+//			},
+//			GetFileContentFunc: func(ctx context.Context, repo Repository, path string, ref string) (string, error) {
+//				panic("mock out the GetFileContent method")
+//			},
 //			GetJobLogsFunc: func(ctx context.Context, repo Repository, jobID int64) (string, error) {
 //				panic("mock out the GetJobLogs method")
 //			},
+//			GetRunFunc: func(ctx context.Context, repo Repository, runID int64) (*Run, error) {
+//				panic("mock out the GetRun method")
+//			},
+//			GetRunAttemptFunc: func(ctx context.Context, repo Repository, runID int64, attempt int) (*Run, error) {
+//				panic("mock out the GetRunAttempt method")
+//			},
+//			GetRunUsageFunc: func(ctx context.Context, repo Repository, runID int64) (*Usage, error) {
+//				panic("mock out the GetRunUsage method")
+//			},
+//			GetWorkflowUsageFunc: func(ctx context.Context, repo Repository, workflowID int64) (*Usage, error) {
+//				panic("mock out the GetWorkflowUsage method")
+//			},
 //			ListJobsFunc: func(ctx context.Context, repo Repository, runID int64) ([]Job, error) {
 //				panic("mock out the ListJobs method")
 //			},
+//			ListJobsForAttemptFunc: func(ctx context.Context, repo Repository, runID int64, attempt int) ([]Job, error) {
+//				panic("mock out the ListJobsForAttempt method")
+//			},
+//			ListPendingDeploymentsFunc: func(ctx context.Context, repo Repository, runID int64) ([]PendingDeployment, error) {
+//				panic("mock out the ListPendingDeployments method")
+//			},
 //			ListRunsFunc: func(ctx context.Context, repo Repository, opts *ListRunsOpts) ([]Run, error) {
 //				panic("mock out the ListRuns method")
 //			},
 //			ListWorkflowsFunc: func(ctx context.Context, repo Repository) ([]Workflow, error) {
 //				panic("mock out the ListWorkflows method")
 //			},
+//			RateLimitFunc: func() RateLimit {
+//				panic("mock out the RateLimit method")
+//			},
 //			RateLimitRemainingFunc: func() int {
 //				panic("mock out the RateLimitRemaining method")
 //			},
-//			RerunFailedJobsFunc: func(ctx context.Context, repo Repository, runID int64) error {
+//			RerunFailedJobsFunc: func(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
 //				panic("mock out the RerunFailedJobs method")
 //			},
-//			RerunWorkflowFunc: func(ctx context.Context, repo Repository, runID int64) error {
+//			RerunJobFunc: func(ctx context.Context, repo Repository, jobID int64, opts *RerunOpts) error {
+//				panic("mock out the RerunJob method")
+//			},
+//			RerunWorkflowFunc: func(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
 //				panic("mock out the RerunWorkflow method")
 //			},
+//			ReviewPendingDeploymentsFunc: func(ctx context.Context, repo Repository, runID int64, environmentIDs []int64, approve bool, comment string) error {
+//				panic("mock out the ReviewPendingDeployments method")
+//			},
 //			TriggerWorkflowFunc: func(ctx context.Context, repo Repository, workflowFile string, ref string, inputs map[string]interface{}) error {
 //				panic("mock out the TriggerWorkflow method")
 //			},
@@ -55,26 +103,74 @@ type MockClient struct {
 	// CancelRunFunc mocks the CancelRun method.
 	CancelRunFunc func(ctx context.Context, repo Repository, runID int64) error
 
+	// DeleteRunFunc mocks the DeleteRun method.
+	DeleteRunFunc func(ctx context.Context, repo Repository, runID int64) error
+
+	// DeleteRunLogsFunc mocks the DeleteRunLogs method.
+	DeleteRunLogsFunc func(ctx context.Context, repo Repository, runID int64) error
+
+	// DisableWorkflowFunc mocks the DisableWorkflow method.
+	DisableWorkflowFunc func(ctx context.Context, repo Repository, workflowID int64) error
+
+	// EnableWorkflowFunc mocks the EnableWorkflow method.
+	EnableWorkflowFunc func(ctx context.Context, repo Repository, workflowID int64) error
+
+	// ForceCancelRunFunc mocks the ForceCancelRun method.
+	ForceCancelRunFunc func(ctx context.Context, repo Repository, runID int64) error
+
+	// GetDefaultBranchFunc mocks the GetDefaultBranch method.
+	GetDefaultBranchFunc func(ctx context.Context, repo Repository) (string, error)
+
+	// GetFileContentFunc mocks the GetFileContent method.
+	GetFileContentFunc func(ctx context.Context, repo Repository, path string, ref string) (string, error)
+
 	// GetJobLogsFunc mocks the GetJobLogs method.
 	GetJobLogsFunc func(ctx context.Context, repo Repository, jobID int64) (string, error)
 
+	// GetRunFunc mocks the GetRun method.
+	GetRunFunc func(ctx context.Context, repo Repository, runID int64) (*Run, error)
+
+	// GetRunAttemptFunc mocks the GetRunAttempt method.
+	GetRunAttemptFunc func(ctx context.Context, repo Repository, runID int64, attempt int) (*Run, error)
+
+	// GetRunUsageFunc mocks the GetRunUsage method.
+	GetRunUsageFunc func(ctx context.Context, repo Repository, runID int64) (*Usage, error)
+
+	// GetWorkflowUsageFunc mocks the GetWorkflowUsage method.
+	GetWorkflowUsageFunc func(ctx context.Context, repo Repository, workflowID int64) (*Usage, error)
+
 	// ListJobsFunc mocks the ListJobs method.
 	ListJobsFunc func(ctx context.Context, repo Repository, runID int64) ([]Job, error)
 
+	// ListJobsForAttemptFunc mocks the ListJobsForAttempt method.
+	ListJobsForAttemptFunc func(ctx context.Context, repo Repository, runID int64, attempt int) ([]Job, error)
+
+	// ListPendingDeploymentsFunc mocks the ListPendingDeployments method.
+	ListPendingDeploymentsFunc func(ctx context.Context, repo Repository, runID int64) ([]PendingDeployment, error)
+
 	// ListRunsFunc mocks the ListRuns method.
 	ListRunsFunc func(ctx context.Context, repo Repository, opts *ListRunsOpts) ([]Run, error)
 
 	// ListWorkflowsFunc mocks the ListWorkflows method.
 	ListWorkflowsFunc func(ctx context.Context, repo Repository) ([]Workflow, error)
 
+	// RateLimitFunc mocks the RateLimit method.
+	RateLimitFunc func() RateLimit
+
 	// RateLimitRemainingFunc mocks the RateLimitRemaining method.
 	RateLimitRemainingFunc func() int
 
 	// RerunFailedJobsFunc mocks the RerunFailedJobs method.
-	RerunFailedJobsFunc func(ctx context.Context, repo Repository, runID int64) error
+	RerunFailedJobsFunc func(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error
+
+	// RerunJobFunc mocks the RerunJob method.
+	RerunJobFunc func(ctx context.Context, repo Repository, jobID int64, opts *RerunOpts) error
 
 	// RerunWorkflowFunc mocks the RerunWorkflow method.
-	RerunWorkflowFunc func(ctx context.Context, repo Repository, runID int64) error
+	RerunWorkflowFunc func(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error
+
+	// ReviewPendingDeploymentsFunc mocks the ReviewPendingDeployments method.
+	ReviewPendingDeploymentsFunc func(ctx context.Context, repo Repository, runID int64, environmentIDs []int64, approve bool, comment string) error
 
 	// TriggerWorkflowFunc mocks the TriggerWorkflow method.
 	TriggerWorkflowFunc func(ctx context.Context, repo Repository, workflowFile string, ref string, inputs map[string]interface{}) error
@@ -90,6 +186,69 @@ type MockClient struct {
 			// RunID is the runID argument value.
 			RunID int64
 		}
+		// DeleteRun holds details about calls to the DeleteRun method.
+		DeleteRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// DeleteRunLogs holds details about calls to the DeleteRunLogs method.
+		DeleteRunLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// DisableWorkflow holds details about calls to the DisableWorkflow method.
+		DisableWorkflow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// WorkflowID is the workflowID argument value.
+			WorkflowID int64
+		}
+		// EnableWorkflow holds details about calls to the EnableWorkflow method.
+		EnableWorkflow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// WorkflowID is the workflowID argument value.
+			WorkflowID int64
+		}
+		// ForceCancelRun holds details about calls to the ForceCancelRun method.
+		ForceCancelRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// GetDefaultBranch holds details about calls to the GetDefaultBranch method.
+		GetDefaultBranch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+		}
+		// GetFileContent holds details about calls to the GetFileContent method.
+		GetFileContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// Path is the path argument value.
+			Path string
+			// Ref is the ref argument value.
+			Ref string
+		}
 		// GetJobLogs holds details about calls to the GetJobLogs method.
 		GetJobLogs []struct {
 			// Ctx is the ctx argument value.
@@ -99,6 +258,44 @@ type MockClient struct {
 			// JobID is the jobID argument value.
 			JobID int64
 		}
+		// GetRun holds details about calls to the GetRun method.
+		GetRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// GetRunAttempt holds details about calls to the GetRunAttempt method.
+		GetRunAttempt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+			// Attempt is the attempt argument value.
+			Attempt int
+		}
+		// GetRunUsage holds details about calls to the GetRunUsage method.
+		GetRunUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// GetWorkflowUsage holds details about calls to the GetWorkflowUsage method.
+		GetWorkflowUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// WorkflowID is the workflowID argument value.
+			WorkflowID int64
+		}
 		// ListJobs holds details about calls to the ListJobs method.
 		ListJobs []struct {
 			// Ctx is the ctx argument value.
@@ -108,6 +305,26 @@ type MockClient struct {
 			// RunID is the runID argument value.
 			RunID int64
 		}
+		// ListJobsForAttempt holds details about calls to the ListJobsForAttempt method.
+		ListJobsForAttempt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+			// Attempt is the attempt argument value.
+			Attempt int
+		}
+		// ListPendingDeployments holds details about calls to the ListPendingDeployments method.
+		ListPendingDeployments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+		}
 		// ListRuns holds details about calls to the ListRuns method.
 		ListRuns []struct {
 			// Ctx is the ctx argument value.
@@ -124,6 +341,9 @@ type MockClient struct {
 			// Repo is the repo argument value.
 			Repo Repository
 		}
+		// RateLimit holds details about calls to the RateLimit method.
+		RateLimit []struct {
+		}
 		// RateLimitRemaining holds details about calls to the RateLimitRemaining method.
 		RateLimitRemaining []struct {
 		}
@@ -135,6 +355,19 @@ type MockClient struct {
 			Repo Repository
 			// RunID is the runID argument value.
 			RunID int64
+			// Opts is the opts argument value.
+			Opts *RerunOpts
+		}
+		// RerunJob holds details about calls to the RerunJob method.
+		RerunJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// JobID is the jobID argument value.
+			JobID int64
+			// Opts is the opts argument value.
+			Opts *RerunOpts
 		}
 		// RerunWorkflow holds details about calls to the RerunWorkflow method.
 		RerunWorkflow []struct {
@@ -144,6 +377,23 @@ type MockClient struct {
 			Repo Repository
 			// RunID is the runID argument value.
 			RunID int64
+			// Opts is the opts argument value.
+			Opts *RerunOpts
+		}
+		// ReviewPendingDeployments holds details about calls to the ReviewPendingDeployments method.
+		ReviewPendingDeployments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Repo is the repo argument value.
+			Repo Repository
+			// RunID is the runID argument value.
+			RunID int64
+			// EnvironmentIDs is the environmentIDs argument value.
+			EnvironmentIDs []int64
+			// Approve is the approve argument value.
+			Approve bool
+			// Comment is the comment argument value.
+			Comment string
 		}
 		// TriggerWorkflow holds details about calls to the TriggerWorkflow method.
 		TriggerWorkflow []struct {
@@ -159,15 +409,31 @@ type MockClient struct {
 			Inputs map[string]interface{}
 		}
 	}
-	lockCancelRun          sync.RWMutex
-	lockGetJobLogs         sync.RWMutex
-	lockListJobs           sync.RWMutex
-	lockListRuns           sync.RWMutex
-	lockListWorkflows      sync.RWMutex
-	lockRateLimitRemaining sync.RWMutex
-	lockRerunFailedJobs    sync.RWMutex
-	lockRerunWorkflow      sync.RWMutex
-	lockTriggerWorkflow    sync.RWMutex
+	lockCancelRun                sync.RWMutex
+	lockDeleteRun                sync.RWMutex
+	lockDeleteRunLogs            sync.RWMutex
+	lockDisableWorkflow          sync.RWMutex
+	lockEnableWorkflow           sync.RWMutex
+	lockForceCancelRun           sync.RWMutex
+	lockGetDefaultBranch         sync.RWMutex
+	lockGetFileContent           sync.RWMutex
+	lockGetJobLogs               sync.RWMutex
+	lockGetRun                   sync.RWMutex
+	lockGetRunAttempt            sync.RWMutex
+	lockGetRunUsage              sync.RWMutex
+	lockGetWorkflowUsage         sync.RWMutex
+	lockListJobs                 sync.RWMutex
+	lockListJobsForAttempt       sync.RWMutex
+	lockListPendingDeployments   sync.RWMutex
+	lockListRuns                 sync.RWMutex
+	lockListWorkflows            sync.RWMutex
+	lockRateLimit                sync.RWMutex
+	lockRateLimitRemaining       sync.RWMutex
+	lockRerunFailedJobs          sync.RWMutex
+	lockRerunJob                 sync.RWMutex
+	lockRerunWorkflow            sync.RWMutex
+	lockReviewPendingDeployments sync.RWMutex
+	lockTriggerWorkflow          sync.RWMutex
 }
 
 // CancelRun calls CancelRunFunc.
@@ -210,6 +476,286 @@ func (mock *MockClient) CancelRunCalls() []struct {
 	return calls
 }
 
+// DeleteRun calls DeleteRunFunc.
+func (mock *MockClient) DeleteRun(ctx context.Context, repo Repository, runID int64) error {
+	if mock.DeleteRunFunc == nil {
+		panic("MockClient.DeleteRunFunc: method is nil but Client.DeleteRun was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockDeleteRun.Lock()
+	mock.calls.DeleteRun = append(mock.calls.DeleteRun, callInfo)
+	mock.lockDeleteRun.Unlock()
+	return mock.DeleteRunFunc(ctx, repo, runID)
+}
+
+// DeleteRunCalls gets all the calls that were made to DeleteRun.
+// Check the length with:
+//
+//	len(mockedClient.DeleteRunCalls())
+func (mock *MockClient) DeleteRunCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockDeleteRun.RLock()
+	calls = mock.calls.DeleteRun
+	mock.lockDeleteRun.RUnlock()
+	return calls
+}
+
+// DeleteRunLogs calls DeleteRunLogsFunc.
+func (mock *MockClient) DeleteRunLogs(ctx context.Context, repo Repository, runID int64) error {
+	if mock.DeleteRunLogsFunc == nil {
+		panic("MockClient.DeleteRunLogsFunc: method is nil but Client.DeleteRunLogs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockDeleteRunLogs.Lock()
+	mock.calls.DeleteRunLogs = append(mock.calls.DeleteRunLogs, callInfo)
+	mock.lockDeleteRunLogs.Unlock()
+	return mock.DeleteRunLogsFunc(ctx, repo, runID)
+}
+
+// DeleteRunLogsCalls gets all the calls that were made to DeleteRunLogs.
+// Check the length with:
+//
+//	len(mockedClient.DeleteRunLogsCalls())
+func (mock *MockClient) DeleteRunLogsCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockDeleteRunLogs.RLock()
+	calls = mock.calls.DeleteRunLogs
+	mock.lockDeleteRunLogs.RUnlock()
+	return calls
+}
+
+// DisableWorkflow calls DisableWorkflowFunc.
+func (mock *MockClient) DisableWorkflow(ctx context.Context, repo Repository, workflowID int64) error {
+	if mock.DisableWorkflowFunc == nil {
+		panic("MockClient.DisableWorkflowFunc: method is nil but Client.DisableWorkflow was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}{
+		Ctx:        ctx,
+		Repo:       repo,
+		WorkflowID: workflowID,
+	}
+	mock.lockDisableWorkflow.Lock()
+	mock.calls.DisableWorkflow = append(mock.calls.DisableWorkflow, callInfo)
+	mock.lockDisableWorkflow.Unlock()
+	return mock.DisableWorkflowFunc(ctx, repo, workflowID)
+}
+
+// DisableWorkflowCalls gets all the calls that were made to DisableWorkflow.
+// Check the length with:
+//
+//	len(mockedClient.DisableWorkflowCalls())
+func (mock *MockClient) DisableWorkflowCalls() []struct {
+	Ctx        context.Context
+	Repo       Repository
+	WorkflowID int64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}
+	mock.lockDisableWorkflow.RLock()
+	calls = mock.calls.DisableWorkflow
+	mock.lockDisableWorkflow.RUnlock()
+	return calls
+}
+
+// EnableWorkflow calls EnableWorkflowFunc.
+func (mock *MockClient) EnableWorkflow(ctx context.Context, repo Repository, workflowID int64) error {
+	if mock.EnableWorkflowFunc == nil {
+		panic("MockClient.EnableWorkflowFunc: method is nil but Client.EnableWorkflow was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}{
+		Ctx:        ctx,
+		Repo:       repo,
+		WorkflowID: workflowID,
+	}
+	mock.lockEnableWorkflow.Lock()
+	mock.calls.EnableWorkflow = append(mock.calls.EnableWorkflow, callInfo)
+	mock.lockEnableWorkflow.Unlock()
+	return mock.EnableWorkflowFunc(ctx, repo, workflowID)
+}
+
+// EnableWorkflowCalls gets all the calls that were made to EnableWorkflow.
+// Check the length with:
+//
+//	len(mockedClient.EnableWorkflowCalls())
+func (mock *MockClient) EnableWorkflowCalls() []struct {
+	Ctx        context.Context
+	Repo       Repository
+	WorkflowID int64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}
+	mock.lockEnableWorkflow.RLock()
+	calls = mock.calls.EnableWorkflow
+	mock.lockEnableWorkflow.RUnlock()
+	return calls
+}
+
+// ForceCancelRun calls ForceCancelRunFunc.
+func (mock *MockClient) ForceCancelRun(ctx context.Context, repo Repository, runID int64) error {
+	if mock.ForceCancelRunFunc == nil {
+		panic("MockClient.ForceCancelRunFunc: method is nil but Client.ForceCancelRun was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockForceCancelRun.Lock()
+	mock.calls.ForceCancelRun = append(mock.calls.ForceCancelRun, callInfo)
+	mock.lockForceCancelRun.Unlock()
+	return mock.ForceCancelRunFunc(ctx, repo, runID)
+}
+
+// ForceCancelRunCalls gets all the calls that were made to ForceCancelRun.
+// Check the length with:
+//
+//	len(mockedClient.ForceCancelRunCalls())
+func (mock *MockClient) ForceCancelRunCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockForceCancelRun.RLock()
+	calls = mock.calls.ForceCancelRun
+	mock.lockForceCancelRun.RUnlock()
+	return calls
+}
+
+// GetDefaultBranch calls GetDefaultBranchFunc.
+func (mock *MockClient) GetDefaultBranch(ctx context.Context, repo Repository) (string, error) {
+	if mock.GetDefaultBranchFunc == nil {
+		panic("MockClient.GetDefaultBranchFunc: method is nil but Client.GetDefaultBranch was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Repo Repository
+	}{
+		Ctx:  ctx,
+		Repo: repo,
+	}
+	mock.lockGetDefaultBranch.Lock()
+	mock.calls.GetDefaultBranch = append(mock.calls.GetDefaultBranch, callInfo)
+	mock.lockGetDefaultBranch.Unlock()
+	return mock.GetDefaultBranchFunc(ctx, repo)
+}
+
+// GetDefaultBranchCalls gets all the calls that were made to GetDefaultBranch.
+// Check the length with:
+//
+//	len(mockedClient.GetDefaultBranchCalls())
+func (mock *MockClient) GetDefaultBranchCalls() []struct {
+	Ctx  context.Context
+	Repo Repository
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Repo Repository
+	}
+	mock.lockGetDefaultBranch.RLock()
+	calls = mock.calls.GetDefaultBranch
+	mock.lockGetDefaultBranch.RUnlock()
+	return calls
+}
+
+// GetFileContent calls GetFileContentFunc.
+func (mock *MockClient) GetFileContent(ctx context.Context, repo Repository, path string, ref string) (string, error) {
+	if mock.GetFileContentFunc == nil {
+		panic("MockClient.GetFileContentFunc: method is nil but Client.GetFileContent was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Repo Repository
+		Path string
+		Ref  string
+	}{
+		Ctx:  ctx,
+		Repo: repo,
+		Path: path,
+		Ref:  ref,
+	}
+	mock.lockGetFileContent.Lock()
+	mock.calls.GetFileContent = append(mock.calls.GetFileContent, callInfo)
+	mock.lockGetFileContent.Unlock()
+	return mock.GetFileContentFunc(ctx, repo, path, ref)
+}
+
+// GetFileContentCalls gets all the calls that were made to GetFileContent.
+// Check the length with:
+//
+//	len(mockedClient.GetFileContentCalls())
+func (mock *MockClient) GetFileContentCalls() []struct {
+	Ctx  context.Context
+	Repo Repository
+	Path string
+	Ref  string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Repo Repository
+		Path string
+		Ref  string
+	}
+	mock.lockGetFileContent.RLock()
+	calls = mock.calls.GetFileContent
+	mock.lockGetFileContent.RUnlock()
+	return calls
+}
+
 // GetJobLogs calls GetJobLogsFunc.
 func (mock *MockClient) GetJobLogs(ctx context.Context, repo Repository, jobID int64) (string, error) {
 	if mock.GetJobLogsFunc == nil {
@@ -250,6 +796,170 @@ func (mock *MockClient) GetJobLogsCalls() []struct {
 	return calls
 }
 
+// GetRun calls GetRunFunc.
+func (mock *MockClient) GetRun(ctx context.Context, repo Repository, runID int64) (*Run, error) {
+	if mock.GetRunFunc == nil {
+		panic("MockClient.GetRunFunc: method is nil but Client.GetRun was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockGetRun.Lock()
+	mock.calls.GetRun = append(mock.calls.GetRun, callInfo)
+	mock.lockGetRun.Unlock()
+	return mock.GetRunFunc(ctx, repo, runID)
+}
+
+// GetRunCalls gets all the calls that were made to GetRun.
+// Check the length with:
+//
+//	len(mockedClient.GetRunCalls())
+func (mock *MockClient) GetRunCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockGetRun.RLock()
+	calls = mock.calls.GetRun
+	mock.lockGetRun.RUnlock()
+	return calls
+}
+
+// GetRunAttempt calls GetRunAttemptFunc.
+func (mock *MockClient) GetRunAttempt(ctx context.Context, repo Repository, runID int64, attempt int) (*Run, error) {
+	if mock.GetRunAttemptFunc == nil {
+		panic("MockClient.GetRunAttemptFunc: method is nil but Client.GetRunAttempt was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Repo    Repository
+		RunID   int64
+		Attempt int
+	}{
+		Ctx:     ctx,
+		Repo:    repo,
+		RunID:   runID,
+		Attempt: attempt,
+	}
+	mock.lockGetRunAttempt.Lock()
+	mock.calls.GetRunAttempt = append(mock.calls.GetRunAttempt, callInfo)
+	mock.lockGetRunAttempt.Unlock()
+	return mock.GetRunAttemptFunc(ctx, repo, runID, attempt)
+}
+
+// GetRunAttemptCalls gets all the calls that were made to GetRunAttempt.
+// Check the length with:
+//
+//	len(mockedClient.GetRunAttemptCalls())
+func (mock *MockClient) GetRunAttemptCalls() []struct {
+	Ctx     context.Context
+	Repo    Repository
+	RunID   int64
+	Attempt int
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Repo    Repository
+		RunID   int64
+		Attempt int
+	}
+	mock.lockGetRunAttempt.RLock()
+	calls = mock.calls.GetRunAttempt
+	mock.lockGetRunAttempt.RUnlock()
+	return calls
+}
+
+// GetRunUsage calls GetRunUsageFunc.
+func (mock *MockClient) GetRunUsage(ctx context.Context, repo Repository, runID int64) (*Usage, error) {
+	if mock.GetRunUsageFunc == nil {
+		panic("MockClient.GetRunUsageFunc: method is nil but Client.GetRunUsage was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockGetRunUsage.Lock()
+	mock.calls.GetRunUsage = append(mock.calls.GetRunUsage, callInfo)
+	mock.lockGetRunUsage.Unlock()
+	return mock.GetRunUsageFunc(ctx, repo, runID)
+}
+
+// GetRunUsageCalls gets all the calls that were made to GetRunUsage.
+// Check the length with:
+//
+//	len(mockedClient.GetRunUsageCalls())
+func (mock *MockClient) GetRunUsageCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockGetRunUsage.RLock()
+	calls = mock.calls.GetRunUsage
+	mock.lockGetRunUsage.RUnlock()
+	return calls
+}
+
+// GetWorkflowUsage calls GetWorkflowUsageFunc.
+func (mock *MockClient) GetWorkflowUsage(ctx context.Context, repo Repository, workflowID int64) (*Usage, error) {
+	if mock.GetWorkflowUsageFunc == nil {
+		panic("MockClient.GetWorkflowUsageFunc: method is nil but Client.GetWorkflowUsage was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}{
+		Ctx:        ctx,
+		Repo:       repo,
+		WorkflowID: workflowID,
+	}
+	mock.lockGetWorkflowUsage.Lock()
+	mock.calls.GetWorkflowUsage = append(mock.calls.GetWorkflowUsage, callInfo)
+	mock.lockGetWorkflowUsage.Unlock()
+	return mock.GetWorkflowUsageFunc(ctx, repo, workflowID)
+}
+
+// GetWorkflowUsageCalls gets all the calls that were made to GetWorkflowUsage.
+// Check the length with:
+//
+//	len(mockedClient.GetWorkflowUsageCalls())
+func (mock *MockClient) GetWorkflowUsageCalls() []struct {
+	Ctx        context.Context
+	Repo       Repository
+	WorkflowID int64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Repo       Repository
+		WorkflowID int64
+	}
+	mock.lockGetWorkflowUsage.RLock()
+	calls = mock.calls.GetWorkflowUsage
+	mock.lockGetWorkflowUsage.RUnlock()
+	return calls
+}
+
 // ListJobs calls ListJobsFunc.
 func (mock *MockClient) ListJobs(ctx context.Context, repo Repository, runID int64) ([]Job, error) {
 	if mock.ListJobsFunc == nil {
@@ -290,6 +1000,90 @@ func (mock *MockClient) ListJobsCalls() []struct {
 	return calls
 }
 
+// ListJobsForAttempt calls ListJobsForAttemptFunc.
+func (mock *MockClient) ListJobsForAttempt(ctx context.Context, repo Repository, runID int64, attempt int) ([]Job, error) {
+	if mock.ListJobsForAttemptFunc == nil {
+		panic("MockClient.ListJobsForAttemptFunc: method is nil but Client.ListJobsForAttempt was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Repo    Repository
+		RunID   int64
+		Attempt int
+	}{
+		Ctx:     ctx,
+		Repo:    repo,
+		RunID:   runID,
+		Attempt: attempt,
+	}
+	mock.lockListJobsForAttempt.Lock()
+	mock.calls.ListJobsForAttempt = append(mock.calls.ListJobsForAttempt, callInfo)
+	mock.lockListJobsForAttempt.Unlock()
+	return mock.ListJobsForAttemptFunc(ctx, repo, runID, attempt)
+}
+
+// ListJobsForAttemptCalls gets all the calls that were made to ListJobsForAttempt.
+// Check the length with:
+//
+//	len(mockedClient.ListJobsForAttemptCalls())
+func (mock *MockClient) ListJobsForAttemptCalls() []struct {
+	Ctx     context.Context
+	Repo    Repository
+	RunID   int64
+	Attempt int
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Repo    Repository
+		RunID   int64
+		Attempt int
+	}
+	mock.lockListJobsForAttempt.RLock()
+	calls = mock.calls.ListJobsForAttempt
+	mock.lockListJobsForAttempt.RUnlock()
+	return calls
+}
+
+// ListPendingDeployments calls ListPendingDeploymentsFunc.
+func (mock *MockClient) ListPendingDeployments(ctx context.Context, repo Repository, runID int64) ([]PendingDeployment, error) {
+	if mock.ListPendingDeploymentsFunc == nil {
+		panic("MockClient.ListPendingDeploymentsFunc: method is nil but Client.ListPendingDeployments was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockListPendingDeployments.Lock()
+	mock.calls.ListPendingDeployments = append(mock.calls.ListPendingDeployments, callInfo)
+	mock.lockListPendingDeployments.Unlock()
+	return mock.ListPendingDeploymentsFunc(ctx, repo, runID)
+}
+
+// ListPendingDeploymentsCalls gets all the calls that were made to ListPendingDeployments.
+// Check the length with:
+//
+//	len(mockedClient.ListPendingDeploymentsCalls())
+func (mock *MockClient) ListPendingDeploymentsCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		RunID int64
+	}
+	mock.lockListPendingDeployments.RLock()
+	calls = mock.calls.ListPendingDeployments
+	mock.lockListPendingDeployments.RUnlock()
+	return calls
+}
+
 // ListRuns calls ListRunsFunc.
 func (mock *MockClient) ListRuns(ctx context.Context, repo Repository, opts *ListRunsOpts) ([]Run, error) {
 	if mock.ListRunsFunc == nil {
@@ -366,6 +1160,33 @@ func (mock *MockClient) ListWorkflowsCalls() []struct {
 	return calls
 }
 
+// RateLimit calls RateLimitFunc.
+func (mock *MockClient) RateLimit() RateLimit {
+	if mock.RateLimitFunc == nil {
+		panic("MockClient.RateLimitFunc: method is nil but Client.RateLimit was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockRateLimit.Lock()
+	mock.calls.RateLimit = append(mock.calls.RateLimit, callInfo)
+	mock.lockRateLimit.Unlock()
+	return mock.RateLimitFunc()
+}
+
+// RateLimitCalls gets all the calls that were made to RateLimit.
+// Check the length with:
+//
+//	len(mockedClient.RateLimitCalls())
+func (mock *MockClient) RateLimitCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockRateLimit.RLock()
+	calls = mock.calls.RateLimit
+	mock.lockRateLimit.RUnlock()
+	return calls
+}
+
 // RateLimitRemaining calls RateLimitRemainingFunc.
 func (mock *MockClient) RateLimitRemaining() int {
 	if mock.RateLimitRemainingFunc == nil {
@@ -394,7 +1215,7 @@ func (mock *MockClient) RateLimitRemainingCalls() []struct {
 }
 
 // RerunFailedJobs calls RerunFailedJobsFunc.
-func (mock *MockClient) RerunFailedJobs(ctx context.Context, repo Repository, runID int64) error {
+func (mock *MockClient) RerunFailedJobs(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
 	if mock.RerunFailedJobsFunc == nil {
 		panic("MockClient.RerunFailedJobsFunc: method is nil but Client.RerunFailedJobs was just called")
 	}
@@ -402,15 +1223,17 @@ func (mock *MockClient) RerunFailedJobs(ctx context.Context, repo Repository, ru
 		Ctx   context.Context
 		Repo  Repository
 		RunID int64
+		Opts  *RerunOpts
 	}{
 		Ctx:   ctx,
 		Repo:  repo,
 		RunID: runID,
+		Opts:  opts,
 	}
 	mock.lockRerunFailedJobs.Lock()
 	mock.calls.RerunFailedJobs = append(mock.calls.RerunFailedJobs, callInfo)
 	mock.lockRerunFailedJobs.Unlock()
-	return mock.RerunFailedJobsFunc(ctx, repo, runID)
+	return mock.RerunFailedJobsFunc(ctx, repo, runID, opts)
 }
 
 // RerunFailedJobsCalls gets all the calls that were made to RerunFailedJobs.
@@ -421,11 +1244,13 @@ func (mock *MockClient) RerunFailedJobsCalls() []struct {
 	Ctx   context.Context
 	Repo  Repository
 	RunID int64
+	Opts  *RerunOpts
 } {
 	var calls []struct {
 		Ctx   context.Context
 		Repo  Repository
 		RunID int64
+		Opts  *RerunOpts
 	}
 	mock.lockRerunFailedJobs.RLock()
 	calls = mock.calls.RerunFailedJobs
@@ -433,8 +1258,52 @@ func (mock *MockClient) RerunFailedJobsCalls() []struct {
 	return calls
 }
 
+// RerunJob calls RerunJobFunc.
+func (mock *MockClient) RerunJob(ctx context.Context, repo Repository, jobID int64, opts *RerunOpts) error {
+	if mock.RerunJobFunc == nil {
+		panic("MockClient.RerunJobFunc: method is nil but Client.RerunJob was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Repo  Repository
+		JobID int64
+		Opts  *RerunOpts
+	}{
+		Ctx:   ctx,
+		Repo:  repo,
+		JobID: jobID,
+		Opts:  opts,
+	}
+	mock.lockRerunJob.Lock()
+	mock.calls.RerunJob = append(mock.calls.RerunJob, callInfo)
+	mock.lockRerunJob.Unlock()
+	return mock.RerunJobFunc(ctx, repo, jobID, opts)
+}
+
+// RerunJobCalls gets all the calls that were made to RerunJob.
+// Check the length with:
+//
+//	len(mockedClient.RerunJobCalls())
+func (mock *MockClient) RerunJobCalls() []struct {
+	Ctx   context.Context
+	Repo  Repository
+	JobID int64
+	Opts  *RerunOpts
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Repo  Repository
+		JobID int64
+		Opts  *RerunOpts
+	}
+	mock.lockRerunJob.RLock()
+	calls = mock.calls.RerunJob
+	mock.lockRerunJob.RUnlock()
+	return calls
+}
+
 // RerunWorkflow calls RerunWorkflowFunc.
-func (mock *MockClient) RerunWorkflow(ctx context.Context, repo Repository, runID int64) error {
+func (mock *MockClient) RerunWorkflow(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error {
 	if mock.RerunWorkflowFunc == nil {
 		panic("MockClient.RerunWorkflowFunc: method is nil but Client.RerunWorkflow was just called")
 	}
@@ -442,15 +1311,17 @@ func (mock *MockClient) RerunWorkflow(ctx context.Context, repo Repository, runI
 		Ctx   context.Context
 		Repo  Repository
 		RunID int64
+		Opts  *RerunOpts
 	}{
 		Ctx:   ctx,
 		Repo:  repo,
 		RunID: runID,
+		Opts:  opts,
 	}
 	mock.lockRerunWorkflow.Lock()
 	mock.calls.RerunWorkflow = append(mock.calls.RerunWorkflow, callInfo)
 	mock.lockRerunWorkflow.Unlock()
-	return mock.RerunWorkflowFunc(ctx, repo, runID)
+	return mock.RerunWorkflowFunc(ctx, repo, runID, opts)
 }
 
 // RerunWorkflowCalls gets all the calls that were made to RerunWorkflow.
@@ -461,11 +1332,13 @@ func (mock *MockClient) RerunWorkflowCalls() []struct {
 	Ctx   context.Context
 	Repo  Repository
 	RunID int64
+	Opts  *RerunOpts
 } {
 	var calls []struct {
 		Ctx   context.Context
 		Repo  Repository
 		RunID int64
+		Opts  *RerunOpts
 	}
 	mock.lockRerunWorkflow.RLock()
 	calls = mock.calls.RerunWorkflow
@@ -473,6 +1346,58 @@ func (mock *MockClient) RerunWorkflowCalls() []struct {
 	return calls
 }
 
+// ReviewPendingDeployments calls ReviewPendingDeploymentsFunc.
+func (mock *MockClient) ReviewPendingDeployments(ctx context.Context, repo Repository, runID int64, environmentIDs []int64, approve bool, comment string) error {
+	if mock.ReviewPendingDeploymentsFunc == nil {
+		panic("MockClient.ReviewPendingDeploymentsFunc: method is nil but Client.ReviewPendingDeployments was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		Repo           Repository
+		RunID          int64
+		EnvironmentIDs []int64
+		Approve        bool
+		Comment        string
+	}{
+		Ctx:            ctx,
+		Repo:           repo,
+		RunID:          runID,
+		EnvironmentIDs: environmentIDs,
+		Approve:        approve,
+		Comment:        comment,
+	}
+	mock.lockReviewPendingDeployments.Lock()
+	mock.calls.ReviewPendingDeployments = append(mock.calls.ReviewPendingDeployments, callInfo)
+	mock.lockReviewPendingDeployments.Unlock()
+	return mock.ReviewPendingDeploymentsFunc(ctx, repo, runID, environmentIDs, approve, comment)
+}
+
+// ReviewPendingDeploymentsCalls gets all the calls that were made to ReviewPendingDeployments.
+// Check the length with:
+//
+//	len(mockedClient.ReviewPendingDeploymentsCalls())
+func (mock *MockClient) ReviewPendingDeploymentsCalls() []struct {
+	Ctx            context.Context
+	Repo           Repository
+	RunID          int64
+	EnvironmentIDs []int64
+	Approve        bool
+	Comment        string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		Repo           Repository
+		RunID          int64
+		EnvironmentIDs []int64
+		Approve        bool
+		Comment        string
+	}
+	mock.lockReviewPendingDeployments.RLock()
+	calls = mock.calls.ReviewPendingDeployments
+	mock.lockReviewPendingDeployments.RUnlock()
+	return calls
+}
+
 // TriggerWorkflow calls TriggerWorkflowFunc.
 func (mock *MockClient) TriggerWorkflow(ctx context.Context, repo Repository, workflowFile string, ref string, inputs map[string]interface{}) error {
 	if mock.TriggerWorkflowFunc == nil {
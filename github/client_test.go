@@ -1,6 +1,14 @@
 package github
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +44,48 @@ func TestRealClient_RateLimitRemaining(t *testing.T) {
 	}
 }
 
+func TestRealClient_UpdateRateLimit(t *testing.T) {
+	client := NewClient("token", "owner", "repo").(*realClient)
+	reset := time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Resource", "search")
+	client.updateRateLimit(&github.Response{
+		Response: &http.Response{Header: header},
+		Rate: github.Rate{
+			Limit:     30,
+			Remaining: 12,
+			Reset:     github.Timestamp{Time: reset},
+		},
+	})
+
+	rate := client.RateLimit()
+	if rate.Limit != 30 || rate.Remaining != 12 {
+		t.Errorf("RateLimit() = %d/%d, want 12/30", rate.Remaining, rate.Limit)
+	}
+	if !rate.Reset.Equal(reset) {
+		t.Errorf("RateLimit().Reset = %v, want %v", rate.Reset, reset)
+	}
+	if rate.Resource != "search" {
+		t.Errorf("RateLimit().Resource = %q, want search", rate.Resource)
+	}
+	if client.RateLimitRemaining() != 12 {
+		t.Errorf("RateLimitRemaining() = %d, want 12", client.RateLimitRemaining())
+	}
+}
+
+func TestRealClient_UpdateRateLimit_IgnoresMissingHeaders(t *testing.T) {
+	client := NewClient("token", "owner", "repo").(*realClient)
+
+	client.updateRateLimit(nil)
+	client.updateRateLimit(&github.Response{Response: &http.Response{Header: http.Header{}}})
+
+	rate := client.RateLimit()
+	if rate.Limit != 5000 || rate.Remaining != 5000 {
+		t.Errorf("RateLimit() = %d/%d, want default 5000/5000", rate.Remaining, rate.Limit)
+	}
+}
+
 func TestTokenTransport_SetsAuthHeader(t *testing.T) {
 	// This is a basic test to ensure the transport is created
 	transport := &tokenTransport{token: "test-token"}
@@ -44,6 +94,452 @@ func TestTokenTransport_SetsAuthHeader(t *testing.T) {
 	}
 }
 
+func TestRealClient_GetJobLogs(t *testing.T) {
+	var downloadAuth string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/42/logs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("API request Authorization = %q, want bearer token", r.Header.Get("Authorization"))
+		}
+		http.Redirect(w, r, server.URL+"/download/42", http.StatusFound)
+	})
+	mux.HandleFunc("/download/42", func(w http.ResponseWriter, r *http.Request) {
+		downloadAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("log line"))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	logs, err := client.GetJobLogs(context.Background(), Repository{Owner: "owner", Name: "repo"}, 42)
+	if err != nil {
+		t.Fatalf("GetJobLogs() error = %v", err)
+	}
+	if logs != "log line" {
+		t.Errorf("GetJobLogs() = %q, want %q", logs, "log line")
+	}
+	if downloadAuth != "" {
+		t.Errorf("log download Authorization = %q, token must not be sent to the signed URL", downloadAuth)
+	}
+}
+
+func TestRealClient_ListRuns_HeadSHA(t *testing.T) {
+	var query string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("head_sha")
+		_, _ = w.Write([]byte(`{"total_count":1,"workflow_runs":[{"id":1,"head_sha":"abc123"}]}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	runs, err := client.ListRuns(context.Background(), Repository{Owner: "owner", Name: "repo"}, &ListRunsOpts{HeadSHA: "abc123"})
+	if err != nil {
+		t.Fatalf("ListRuns() error = %v", err)
+	}
+	if query != "abc123" {
+		t.Errorf("head_sha query = %q, want abc123", query)
+	}
+	if len(runs) != 1 || runs[0].HeadSHA != "abc123" {
+		t.Errorf("ListRuns() = %+v, want one run for abc123", runs)
+	}
+}
+
+func TestRealClient_ListRuns_Page(t *testing.T) {
+	var page, perPage string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/9/runs", func(w http.ResponseWriter, r *http.Request) {
+		page, perPage = r.URL.Query().Get("page"), r.URL.Query().Get("per_page")
+		_, _ = w.Write([]byte(`{"total_count":0,"workflow_runs":[]}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	_, err := client.ListRuns(context.Background(), Repository{Owner: "owner", Name: "repo"}, &ListRunsOpts{WorkflowID: 9, PerPage: 100, Page: 3})
+	if err != nil {
+		t.Fatalf("ListRuns() error = %v", err)
+	}
+	if page != "3" || perPage != "100" {
+		t.Errorf("page/per_page = %q/%q, want 3/100", page, perPage)
+	}
+}
+
+func TestRealClient_GetRun(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":7,"run_number":3,"name":"CI","status":"completed","conclusion":"success"}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	run, err := client.GetRun(context.Background(), Repository{Owner: "owner", Name: "repo"}, 7)
+	if err != nil {
+		t.Fatalf("GetRun() error = %v", err)
+	}
+	if run.ID != 7 || run.RunNumber != 3 || run.Conclusion != "success" {
+		t.Errorf("GetRun() = %+v", *run)
+	}
+
+	if _, err := client.GetRun(context.Background(), Repository{Owner: "owner", Name: "repo"}, 8); err == nil {
+		t.Error("GetRun() for unknown run expected error")
+	}
+}
+
+func TestRealClient_RunAttempt(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/attempts/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":7,"run_number":3,"run_attempt":1,"status":"completed","conclusion":"failure"}`))
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/attempts/1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"total_count":1,"jobs":[{"id":100,"name":"test","status":"completed","conclusion":"failure","run_attempt":1,"html_url":"https://github.com/owner/repo/actions/runs/7/job/100"}]}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+
+	run, err := client.GetRunAttempt(context.Background(), repo, 7, 1)
+	if err != nil {
+		t.Fatalf("GetRunAttempt() error = %v", err)
+	}
+	if run.Attempt != 1 || run.Conclusion != "failure" {
+		t.Errorf("GetRunAttempt() = %+v", *run)
+	}
+
+	jobs, err := client.ListJobsForAttempt(context.Background(), repo, 7, 1)
+	if err != nil {
+		t.Fatalf("ListJobsForAttempt() error = %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != 100 || jobs[0].Conclusion != "failure" || jobs[0].Attempt != 1 || !strings.HasSuffix(jobs[0].URL, "/job/100") {
+		t.Errorf("ListJobsForAttempt() = %+v", jobs)
+	}
+}
+
+func TestRealClient_Usage(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/9/timing", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"billable":{"UBUNTU":{"total_ms":180000},"MACOS":{"total_ms":60000}}}`))
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/timing", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"billable":{"WINDOWS":{"total_ms":120000,"jobs":2}},"run_duration_ms":95000}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+
+	wf, err := client.GetWorkflowUsage(context.Background(), repo, 9)
+	if err != nil {
+		t.Fatalf("GetWorkflowUsage() error = %v", err)
+	}
+	if wf.Billable["UBUNTU"] != 3*time.Minute || wf.Billable["MACOS"] != time.Minute || wf.RunDuration != 0 {
+		t.Errorf("GetWorkflowUsage() = %+v", *wf)
+	}
+
+	run, err := client.GetRunUsage(context.Background(), repo, 7)
+	if err != nil {
+		t.Fatalf("GetRunUsage() error = %v", err)
+	}
+	if run.Billable["WINDOWS"] != 2*time.Minute || run.RunDuration != 95*time.Second {
+		t.Errorf("GetRunUsage() = %+v", *run)
+	}
+}
+
+func TestRealClient_GetDefaultBranch(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"repo","default_branch":"trunk"}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	branch, err := client.GetDefaultBranch(context.Background(), Repository{Owner: "owner", Name: "repo"})
+	if err != nil {
+		t.Fatalf("GetDefaultBranch() error = %v", err)
+	}
+	if branch != "trunk" {
+		t.Errorf("GetDefaultBranch() = %q, want trunk", branch)
+	}
+}
+
+func TestRealClient_GetFileContent(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var ref string
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		ref = r.URL.Query().Get("ref")
+		// "name: CI\n", base64-encoded
+		_, _ = w.Write([]byte(`{"type":"file","encoding":"base64","content":"bmFtZTogQ0kK"}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	content, err := client.GetFileContent(context.Background(), Repository{Owner: "owner", Name: "repo"}, ".github/workflows/ci.yml", "abc123")
+	if err != nil {
+		t.Fatalf("GetFileContent() error = %v", err)
+	}
+	if content != "name: CI\n" {
+		t.Errorf("GetFileContent() = %q, want the decoded file", content)
+	}
+	if ref != "abc123" {
+		t.Errorf("ref = %q, want abc123", ref)
+	}
+}
+
+func TestRealClient_EnableDisableWorkflow(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/9/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+	if err := client.DisableWorkflow(context.Background(), repo, 9); err != nil {
+		t.Fatalf("DisableWorkflow() error = %v", err)
+	}
+	if err := client.EnableWorkflow(context.Background(), repo, 9); err != nil {
+		t.Fatalf("EnableWorkflow() error = %v", err)
+	}
+
+	want := []string{
+		"PUT /repos/owner/repo/actions/workflows/9/disable",
+		"PUT /repos/owner/repo/actions/workflows/9/enable",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
+
+func TestRealClient_Rerun(t *testing.T) {
+	bodies := make(map[string]string)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	record := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("%s %s, want POST", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies[r.URL.Path] = strings.TrimSpace(string(body))
+		w.WriteHeader(http.StatusCreated)
+	}
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/rerun", record)
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/rerun-failed-jobs", record)
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/9/rerun", record)
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+	debug := &RerunOpts{EnableDebugLogging: true}
+
+	if err := client.RerunWorkflow(context.Background(), repo, 7, nil); err != nil {
+		t.Fatalf("RerunWorkflow() error = %v", err)
+	}
+	if err := client.RerunFailedJobs(context.Background(), repo, 7, debug); err != nil {
+		t.Fatalf("RerunFailedJobs() error = %v", err)
+	}
+	if err := client.RerunJob(context.Background(), repo, 9, debug); err != nil {
+		t.Fatalf("RerunJob() error = %v", err)
+	}
+
+	want := map[string]string{
+		"/repos/owner/repo/actions/runs/7/rerun":             "",
+		"/repos/owner/repo/actions/runs/7/rerun-failed-jobs": `{"enable_debug_logging":true}`,
+		"/repos/owner/repo/actions/jobs/9/rerun":             `{"enable_debug_logging":true}`,
+	}
+	for path, body := range want {
+		got, ok := bodies[path]
+		if !ok {
+			t.Errorf("no request to %s", path)
+		} else if got != body {
+			t.Errorf("%s body = %q, want %q", path, got, body)
+		}
+	}
+}
+
+func TestRealClient_ForceCancelRun(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/force-cancel", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+
+	if err := client.ForceCancelRun(context.Background(), repo, 7); err != nil {
+		t.Fatalf("ForceCancelRun() error = %v", err)
+	}
+	if len(requests) != 1 || requests[0] != "POST /repos/owner/repo/actions/runs/7/force-cancel" {
+		t.Errorf("requests = %v", requests)
+	}
+}
+
+func TestRealClient_DeleteRun(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/logs", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/8", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"Must have admin rights to Repository."}`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+	if err := client.DeleteRunLogs(context.Background(), repo, 7); err != nil {
+		t.Fatalf("DeleteRunLogs() error = %v", err)
+	}
+	if err := client.DeleteRun(context.Background(), repo, 7); err != nil {
+		t.Fatalf("DeleteRun() error = %v", err)
+	}
+	want := []string{
+		"DELETE /repos/owner/repo/actions/runs/7/logs",
+		"DELETE /repos/owner/repo/actions/runs/7",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+
+	var appErr *AppError
+	if err := client.DeleteRun(context.Background(), repo, 8); !errors.As(err, &appErr) {
+		t.Errorf("DeleteRun() error = %v, want *AppError", err)
+	}
+}
+
+func TestRealClient_PendingDeployments(t *testing.T) {
+	var review map[string]any
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/runs/7/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+				t.Errorf("decode review: %v", err)
+			}
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"environment":{"id":5,"name":"production","html_url":"https://github.com/owner/repo/deployments/production"},"current_user_can_approve":true}]`))
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	repo := Repository{Owner: "owner", Name: "repo"}
+
+	deployments, err := client.ListPendingDeployments(context.Background(), repo, 7)
+	if err != nil {
+		t.Fatalf("ListPendingDeployments() error = %v", err)
+	}
+	want := PendingDeployment{EnvironmentID: 5, Environment: "production", URL: "https://github.com/owner/repo/deployments/production", CanApprove: true}
+	if len(deployments) != 1 || deployments[0] != want {
+		t.Errorf("ListPendingDeployments() = %+v, want [%+v]", deployments, want)
+	}
+
+	if err := client.ReviewPendingDeployments(context.Background(), repo, 7, []int64{5}, false, "not today"); err != nil {
+		t.Fatalf("ReviewPendingDeployments() error = %v", err)
+	}
+	if review["state"] != "rejected" || review["comment"] != "not today" {
+		t.Errorf("review request = %v, want rejected with comment", review)
+	}
+	if ids, ok := review["environment_ids"].([]any); !ok || len(ids) != 1 || ids[0] != float64(5) {
+		t.Errorf("review environment_ids = %v, want [5]", review["environment_ids"])
+	}
+}
+
+func TestRealClient_GetJobLogs_Timeout(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/42/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL+"/download/42", http.StatusFound)
+	})
+	mux.HandleFunc("/download/42", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	cfg := DefaultHTTPConfig()
+	cfg.ReadTimeout = 50 * time.Millisecond
+	client := newTestClient(t, server.URL, cfg)
+
+	_, err := client.GetJobLogs(context.Background(), Repository{Owner: "owner", Name: "repo"}, 42)
+	if err == nil {
+		t.Fatal("GetJobLogs() should fail when the download stalls")
+	}
+	if !IsNetworkError(err) {
+		t.Errorf("GetJobLogs() error = %v, want a network error", err)
+	}
+}
+
+func TestRealClient_GetJobLogs_ContextCancelled(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/42/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL+"/download/42", http.StatusFound)
+	})
+	mux.HandleFunc("/download/42", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	})
+
+	client := newTestClient(t, server.URL, DefaultHTTPConfig())
+	if _, err := client.GetJobLogs(ctx, Repository{Owner: "owner", Name: "repo"}, 42); err == nil {
+		t.Fatal("GetJobLogs() should fail when the context is cancelled")
+	}
+}
+
+// newTestClient creates a realClient that talks to a test server
+func newTestClient(t *testing.T, serverURL string, cfg HTTPConfig) *realClient {
+	t.Helper()
+	c, err := NewClientWithConfig("test-token", "owner", "repo", cfg)
+	if err != nil {
+		t.Fatalf("NewClientWithConfig() error = %v", err)
+	}
+	client := c.(*realClient)
+	baseURL, _ := url.Parse(serverURL + "/")
+	client.client.BaseURL = baseURL
+	return client
+}
+
 func TestConvertRuns(t *testing.T) {
 	// Helper to create pointers
 	intPtr := func(i int64) *int64 { return &i }
@@ -61,6 +557,7 @@ func TestConvertRuns(t *testing.T) {
 			Status:     strPtr("completed"),
 			Conclusion: strPtr("success"),
 			HeadBranch: strPtr("main"),
+			HeadSHA:    strPtr("abc123"),
 			Event:      strPtr("push"),
 			Actor:      &github.User{Login: strPtr("testuser")},
 			HTMLURL:    strPtr("https://github.com/owner/repo/actions/runs/12345678901"),
@@ -74,9 +571,12 @@ func TestConvertRuns(t *testing.T) {
 			Conclusion: strPtr(""),
 			HeadBranch: strPtr("feature-branch"),
 			Event:      strPtr("pull_request"),
-			Actor:      &github.User{Login: strPtr("anotheruser")},
-			HTMLURL:    strPtr("https://github.com/owner/repo/actions/runs/12345678902"),
-			CreatedAt:  ghTimestamp,
+			PullRequests: []*github.PullRequest{
+				{Number: intValPtr(42)},
+			},
+			Actor:     &github.User{Login: strPtr("anotheruser")},
+			HTMLURL:   strPtr("https://github.com/owner/repo/actions/runs/12345678902"),
+			CreatedAt: ghTimestamp,
 		},
 	}
 
@@ -106,6 +606,9 @@ func TestConvertRuns(t *testing.T) {
 	if r1.Branch != "main" {
 		t.Errorf("Run[0].Branch = %q, want main", r1.Branch)
 	}
+	if r1.HeadSHA != "abc123" {
+		t.Errorf("Run[0].HeadSHA = %q, want abc123", r1.HeadSHA)
+	}
 	if r1.Event != "push" {
 		t.Errorf("Run[0].Event = %q, want push", r1.Event)
 	}
@@ -124,6 +627,12 @@ func TestConvertRuns(t *testing.T) {
 	if r2.Branch != "feature-branch" {
 		t.Errorf("Run[1].Branch = %q, want feature-branch", r2.Branch)
 	}
+	if len(r2.PullRequests) != 1 || r2.PullRequests[0] != 42 {
+		t.Errorf("Run[1].PullRequests = %v, want [42]", r2.PullRequests)
+	}
+	if r1.PullRequests != nil {
+		t.Errorf("Run[0].PullRequests = %v, want none", r1.PullRequests)
+	}
 }
 
 func TestConvertRuns_EmptyInput(t *testing.T) {
@@ -2,7 +2,16 @@ package github
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	ghlib "github.com/google/go-github/v68/github"
@@ -20,6 +29,19 @@ const (
 	ErrTypeUnknown
 )
 
+// Retry timing constants
+const (
+	// InitialBackoff is the first retry wait when the server gives no hint
+	InitialBackoff = time.Second
+	// MaxBackoff caps the exponential backoff between retries
+	MaxBackoff = 30 * time.Second
+	// MaxRetryAfter is the longest server-requested wait that is honored.
+	// Longer waits fail immediately so the user is not left waiting.
+	MaxRetryAfter = 5 * time.Minute
+	// RetryJitterRatio is the maximum random jitter added to each wait
+	RetryJitterRatio = 0.1
+)
+
 // AppError represents an application-level error with additional context.
 type AppError struct {
 	Type       ErrorType
@@ -45,6 +67,36 @@ func WrapAPIError(err error) *AppError {
 		return nil
 	}
 
+	// Primary rate limit: go-github reports the reset time directly
+	var rateErr *ghlib.RateLimitError
+	if errors.As(err, &rateErr) {
+		return &AppError{
+			Type:       ErrTypeRateLimit,
+			Message:    "Rate limit exceeded",
+			Cause:      err,
+			Retryable:  true,
+			RetryAfter: untilReset(rateErr.Rate.Reset.Time, time.Now()),
+		}
+	}
+
+	// Secondary rate limit: GitHub may provide Retry-After
+	var abuseErr *ghlib.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		var retryAfter time.Duration
+		if abuseErr.RetryAfter != nil {
+			retryAfter = *abuseErr.RetryAfter
+		} else if abuseErr.Response != nil {
+			retryAfter = parseRetryAfter(abuseErr.Response.Header, time.Now())
+		}
+		return &AppError{
+			Type:       ErrTypeRateLimit,
+			Message:    "Secondary rate limit exceeded",
+			Cause:      err,
+			Retryable:  true,
+			RetryAfter: retryAfter,
+		}
+	}
+
 	var ghErr *ghlib.ErrorResponse
 	if errors.As(err, &ghErr) {
 		switch ghErr.Response.StatusCode {
@@ -58,10 +110,20 @@ func WrapAPIError(err error) *AppError {
 		case 403:
 			if ghErr.Response.Header.Get("X-RateLimit-Remaining") == "0" {
 				return &AppError{
-					Type:      ErrTypeRateLimit,
-					Message:   "Rate limit exceeded",
-					Cause:     err,
-					Retryable: true,
+					Type:       ErrTypeRateLimit,
+					Message:    "Rate limit exceeded",
+					Cause:      err,
+					Retryable:  true,
+					RetryAfter: parseRetryAfter(ghErr.Response.Header, time.Now()),
+				}
+			}
+			if isSecondaryRateLimit(ghErr) {
+				return &AppError{
+					Type:       ErrTypeRateLimit,
+					Message:    "Secondary rate limit exceeded",
+					Cause:      err,
+					Retryable:  true,
+					RetryAfter: parseRetryAfter(ghErr.Response.Header, time.Now()),
 				}
 			}
 			return &AppError{
@@ -79,10 +141,11 @@ func WrapAPIError(err error) *AppError {
 			}
 		case 429:
 			return &AppError{
-				Type:      ErrTypeRateLimit,
-				Message:   "Too many requests",
-				Cause:     err,
-				Retryable: true,
+				Type:       ErrTypeRateLimit,
+				Message:    "Too many requests",
+				Cause:      err,
+				Retryable:  true,
+				RetryAfter: parseRetryAfter(ghErr.Response.Header, time.Now()),
 			}
 		default:
 			if ghErr.Response.StatusCode >= 500 {
@@ -96,6 +159,15 @@ func WrapAPIError(err error) *AppError {
 		}
 	}
 
+	if IsNetworkError(err) {
+		return &AppError{
+			Type:      ErrTypeNetwork,
+			Message:   "Network unavailable",
+			Cause:     err,
+			Retryable: true,
+		}
+	}
+
 	return &AppError{
 		Type:      ErrTypeUnknown,
 		Message:   "Unexpected error",
@@ -104,6 +176,100 @@ func WrapAPIError(err error) *AppError {
 	}
 }
 
+// IsNetworkError returns true if err was caused by the network rather than the API:
+// DNS failures, refused or reset connections, timeouts and TLS failures.
+// An AppError is classified by its Type.
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Type == ErrTypeNetwork
+	}
+
+	// Cancellation is deliberate, not a connectivity problem
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.ENETUNREACH) ||
+		errors.Is(err, syscall.EHOSTUNREACH) {
+		return true
+	}
+
+	// *url.Error, *net.OpError and *net.DNSError all implement net.Error
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var (
+		recordErr   tls.RecordHeaderError
+		certErr     *tls.CertificateVerificationError
+		unknownAuth x509.UnknownAuthorityError
+		hostnameErr x509.HostnameError
+		invalidCert x509.CertificateInvalidError
+	)
+	return errors.As(err, &recordErr) ||
+		errors.As(err, &certErr) ||
+		errors.As(err, &unknownAuth) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidCert)
+}
+
+// isSecondaryRateLimit returns true if a 403 response is a secondary rate limit.
+// GitHub signals these with a Retry-After header or a "secondary rate limit" message.
+func isSecondaryRateLimit(ghErr *ghlib.ErrorResponse) bool {
+	if ghErr.Response.Header.Get("Retry-After") != "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(ghErr.Message), "secondary rate limit")
+}
+
+// parseRetryAfter returns how long the server asked us to wait.
+// Retry-After (seconds or HTTP date) takes precedence over X-RateLimit-Reset (epoch seconds).
+// Returns 0 if neither header is present or the time has already passed.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	if header == nil {
+		return 0
+	}
+
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if seconds < 0 {
+				return 0
+			}
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return untilReset(at, now)
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" {
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return untilReset(time.Unix(epoch, 0), now)
+		}
+	}
+
+	return 0
+}
+
+// untilReset returns the time remaining until reset, or 0 if it has passed.
+func untilReset(reset, now time.Time) time.Duration {
+	if reset.IsZero() || !reset.After(now) {
+		return 0
+	}
+	return reset.Sub(now)
+}
+
 // IsRetryable returns true if the error can be retried.
 func IsRetryable(err error) bool {
 	if err == nil {
@@ -116,12 +282,24 @@ func IsRetryable(err error) bool {
 	return false
 }
 
+// RetryNotifyFunc is called before each retry wait with the attempt number (1-based),
+// the time until the retry and the error that triggered it.
+type RetryNotifyFunc func(attempt int, wait time.Duration, err error)
+
 // RetryWithBackoff executes fn with exponential backoff retry for retryable errors.
 // It retries up to maxRetries times with exponential backoff starting at 1 second.
 // Maximum backoff is capped at 30 seconds.
 func RetryWithBackoff(ctx context.Context, maxRetries int, fn func() error) error {
+	return RetryWithBackoffNotify(ctx, maxRetries, fn, nil)
+}
+
+// RetryWithBackoffNotify behaves like RetryWithBackoff and calls notify before each wait.
+// When the error carries a server-requested RetryAfter, that wait is used instead of
+// the exponential backoff. Waits longer than MaxRetryAfter are not attempted.
+// Jitter is added to every wait to avoid retrying in lockstep.
+func RetryWithBackoffNotify(ctx context.Context, maxRetries int, fn func() error, notify RetryNotifyFunc) error {
 	var lastErr error
-	backoff := time.Second
+	backoff := InitialBackoff
 
 	for i := 0; i <= maxRetries; i++ {
 		lastErr = fn()
@@ -132,11 +310,24 @@ func RetryWithBackoff(ctx context.Context, maxRetries int, fn func() error) erro
 			return lastErr
 		}
 		if i < maxRetries {
+			wait := backoff
+			if retryAfter := retryAfterOf(lastErr); retryAfter > 0 {
+				if retryAfter > MaxRetryAfter {
+					return lastErr
+				}
+				wait = retryAfter
+			}
+			wait = withJitter(wait)
+
+			if notify != nil {
+				notify(i+1, wait, lastErr)
+			}
+
 			select {
-			case <-time.After(backoff):
+			case <-time.After(wait):
 				backoff = backoff * 2
-				if backoff > 30*time.Second {
-					backoff = 30 * time.Second
+				if backoff > MaxBackoff {
+					backoff = MaxBackoff
 				}
 			case <-ctx.Done():
 				return ctx.Err()
@@ -145,3 +336,21 @@ func RetryWithBackoff(ctx context.Context, maxRetries int, fn func() error) erro
 	}
 	return lastErr
 }
+
+// retryAfterOf returns the server-requested wait carried by err, if any.
+func retryAfterOf(err error) time.Duration {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.RetryAfter
+	}
+	return 0
+}
+
+// withJitter adds up to RetryJitterRatio of random jitter to d.
+func withJitter(d time.Duration) time.Duration {
+	maxJitter := int64(float64(d) * RetryJitterRatio)
+	if maxJitter <= 0 {
+		return d
+	}
+	return d + time.Duration(rand.Int64N(maxJitter+1))
+}
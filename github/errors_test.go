@@ -1,8 +1,14 @@
 package github
 
 import (
+	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"syscall"
 	"testing"
 	"time"
 
@@ -385,3 +391,249 @@ func TestIsRetryable_Nil(t *testing.T) {
 		t.Error("IsRetryable should return false for nil error")
 	}
 }
+
+func TestWrapAPIError_403_SecondaryRateLimit(t *testing.T) {
+	header := make(http.Header)
+	header.Set("X-RateLimit-Remaining", "4000")
+
+	ghErr := &ghlib.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: 403,
+			Header:     header,
+		},
+		Message: "You have exceeded a secondary rate limit. Please wait a few minutes before you try again.",
+	}
+
+	appErr := WrapAPIError(ghErr)
+	if appErr.Type != ErrTypeRateLimit {
+		t.Errorf("AppError.Type = %v, want %v", appErr.Type, ErrTypeRateLimit)
+	}
+	if !appErr.Retryable {
+		t.Error("Secondary rate limit error should be retryable")
+	}
+}
+
+func TestWrapAPIError_403_RetryAfterHeader(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Retry-After", "37")
+
+	ghErr := &ghlib.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: 403,
+			Header:     header,
+		},
+		Message: "Forbidden",
+	}
+
+	appErr := WrapAPIError(ghErr)
+	if appErr.Type != ErrTypeRateLimit {
+		t.Errorf("AppError.Type = %v, want %v", appErr.Type, ErrTypeRateLimit)
+	}
+	if appErr.RetryAfter != 37*time.Second {
+		t.Errorf("AppError.RetryAfter = %v, want 37s", appErr.RetryAfter)
+	}
+}
+
+func TestWrapAPIError_429_RetryAfter(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Retry-After", "5")
+
+	ghErr := &ghlib.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: 429,
+			Header:     header,
+		},
+		Message: "Too Many Requests",
+	}
+
+	appErr := WrapAPIError(ghErr)
+	if appErr.RetryAfter != 5*time.Second {
+		t.Errorf("AppError.RetryAfter = %v, want 5s", appErr.RetryAfter)
+	}
+}
+
+func TestWrapAPIError_RateLimitError(t *testing.T) {
+	rateErr := &ghlib.RateLimitError{
+		Rate: ghlib.Rate{
+			Limit:     5000,
+			Remaining: 0,
+			Reset:     ghlib.Timestamp{Time: time.Now().Add(10 * time.Minute)},
+		},
+		Response: &http.Response{StatusCode: 403},
+		Message:  "API rate limit exceeded",
+	}
+
+	appErr := WrapAPIError(rateErr)
+	if appErr.Type != ErrTypeRateLimit {
+		t.Errorf("AppError.Type = %v, want %v", appErr.Type, ErrTypeRateLimit)
+	}
+	if !appErr.Retryable {
+		t.Error("Rate limit error should be retryable")
+	}
+	if appErr.RetryAfter < 9*time.Minute || appErr.RetryAfter > 10*time.Minute {
+		t.Errorf("AppError.RetryAfter = %v, want about 10m", appErr.RetryAfter)
+	}
+}
+
+func TestWrapAPIError_AbuseRateLimitError(t *testing.T) {
+	retryAfter := 42 * time.Second
+	abuseErr := &ghlib.AbuseRateLimitError{
+		Response:   &http.Response{StatusCode: 403},
+		Message:    "You have exceeded a secondary rate limit",
+		RetryAfter: &retryAfter,
+	}
+
+	appErr := WrapAPIError(abuseErr)
+	if appErr.Type != ErrTypeRateLimit {
+		t.Errorf("AppError.Type = %v, want %v", appErr.Type, ErrTypeRateLimit)
+	}
+	if !appErr.Retryable {
+		t.Error("Secondary rate limit error should be retryable")
+	}
+	if appErr.RetryAfter != retryAfter {
+		t.Errorf("AppError.RetryAfter = %v, want %v", appErr.RetryAfter, retryAfter)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    time.Duration
+	}{
+		{name: "no headers", headers: nil, want: 0},
+		{name: "retry-after seconds", headers: map[string]string{"Retry-After": "30"}, want: 30 * time.Second},
+		{name: "retry-after http date", headers: map[string]string{"Retry-After": now.Add(time.Minute).Format(http.TimeFormat)}, want: time.Minute},
+		{name: "rate limit reset", headers: map[string]string{"X-RateLimit-Reset": "1705313100"}, want: 5 * time.Minute},
+		{name: "reset in the past", headers: map[string]string{"X-RateLimit-Reset": "1705312000"}, want: 0},
+		{name: "retry-after wins", headers: map[string]string{"Retry-After": "10", "X-RateLimit-Reset": "1705313100"}, want: 10 * time.Second},
+		{name: "invalid value", headers: map[string]string{"Retry-After": "soon"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := make(http.Header)
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			if got := parseRetryAfter(header, now); got != tt.want {
+				t.Errorf("parseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryWithBackoffNotify_HonorsRetryAfter(t *testing.T) {
+	retryErr := &AppError{Type: ErrTypeRateLimit, Retryable: true, RetryAfter: 20 * time.Millisecond}
+
+	calls := 0
+	var waits []time.Duration
+	err := RetryWithBackoffNotify(context.Background(), 3, func() error {
+		calls++
+		if calls < 3 {
+			return retryErr
+		}
+		return nil
+	}, func(attempt int, wait time.Duration, err error) {
+		waits = append(waits, wait)
+	})
+
+	if err != nil {
+		t.Fatalf("RetryWithBackoffNotify() error = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+	if len(waits) != 2 {
+		t.Fatalf("notify called %d times, want 2", len(waits))
+	}
+	for _, w := range waits {
+		maxWait := 20*time.Millisecond + time.Duration(float64(20*time.Millisecond)*RetryJitterRatio)
+		if w < 20*time.Millisecond || w > maxWait {
+			t.Errorf("wait = %v, want between 20ms and %v", w, maxWait)
+		}
+	}
+}
+
+func TestRetryWithBackoffNotify_GivesUpOnLongRetryAfter(t *testing.T) {
+	retryErr := &AppError{Type: ErrTypeRateLimit, Retryable: true, RetryAfter: MaxRetryAfter + time.Minute}
+
+	calls := 0
+	err := RetryWithBackoffNotify(context.Background(), 3, func() error {
+		calls++
+		return retryErr
+	}, func(int, time.Duration, error) {
+		t.Error("notify should not be called when the wait exceeds MaxRetryAfter")
+	})
+
+	if !errors.Is(err, retryErr) {
+		t.Errorf("RetryWithBackoffNotify() error = %v, want %v", err, retryErr)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	base := time.Second
+	for i := 0; i < 100; i++ {
+		got := withJitter(base)
+		if got < base || got > base+time.Duration(float64(base)*RetryJitterRatio) {
+			t.Fatalf("withJitter(%v) = %v, out of range", base, got)
+		}
+	}
+
+	if got := withJitter(0); got != 0 {
+		t.Errorf("withJitter(0) = %v, want 0", got)
+	}
+}
+
+func TestWrapAPIError_NetworkError(t *testing.T) {
+	netErr := &url.Error{
+		Op:  "Get",
+		URL: "https://api.github.com/repos/owner/repo/actions/workflows",
+		Err: &net.DNSError{Err: "no such host", Name: "api.github.com", IsNotFound: true},
+	}
+
+	appErr := WrapAPIError(netErr)
+	if appErr.Type != ErrTypeNetwork {
+		t.Errorf("AppError.Type = %v, want %v", appErr.Type, ErrTypeNetwork)
+	}
+	if !appErr.Retryable {
+		t.Error("Network error should be retryable")
+	}
+	if !errors.Is(appErr, netErr) {
+		t.Error("AppError should wrap the original error")
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+		{name: "dns error", err: &net.DNSError{Err: "no such host", Name: "api.github.com"}, want: true},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, want: true},
+		{name: "connection reset", err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		{name: "url error", err: &url.Error{Op: "Get", URL: "https://api.github.com", Err: errors.New("EOF")}, want: true},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, want: true},
+		{name: "canceled", err: context.Canceled, want: false},
+		{name: "canceled url error", err: &url.Error{Op: "Get", URL: "https://api.github.com", Err: context.Canceled}, want: false},
+		{name: "unknown authority", err: x509.UnknownAuthorityError{}, want: true},
+		{name: "network app error", err: &AppError{Type: ErrTypeNetwork}, want: true},
+		{name: "server app error", err: &AppError{Type: ErrTypeServer}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
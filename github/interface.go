@@ -7,22 +7,42 @@ import "context"
 // Client is the interface for GitHub API operations.
 // It enables dependency injection for testing.
 type Client interface {
+	// Repository
+	GetDefaultBranch(ctx context.Context, repo Repository) (string, error)
+	GetFileContent(ctx context.Context, repo Repository, path, ref string) (string, error)
+
 	// Workflows
 	ListWorkflows(ctx context.Context, repo Repository) ([]Workflow, error)
+	EnableWorkflow(ctx context.Context, repo Repository, workflowID int64) error
+	DisableWorkflow(ctx context.Context, repo Repository, workflowID int64) error
+	GetWorkflowUsage(ctx context.Context, repo Repository, workflowID int64) (*Usage, error)
 
 	// Runs
 	ListRuns(ctx context.Context, repo Repository, opts *ListRunsOpts) ([]Run, error)
+	GetRun(ctx context.Context, repo Repository, runID int64) (*Run, error)
+	GetRunAttempt(ctx context.Context, repo Repository, runID int64, attempt int) (*Run, error)
+	GetRunUsage(ctx context.Context, repo Repository, runID int64) (*Usage, error)
 	CancelRun(ctx context.Context, repo Repository, runID int64) error
-	RerunWorkflow(ctx context.Context, repo Repository, runID int64) error
-	RerunFailedJobs(ctx context.Context, repo Repository, runID int64) error
+	ForceCancelRun(ctx context.Context, repo Repository, runID int64) error
+	RerunWorkflow(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error
+	RerunFailedJobs(ctx context.Context, repo Repository, runID int64, opts *RerunOpts) error
 	TriggerWorkflow(ctx context.Context, repo Repository, workflowFile, ref string, inputs map[string]interface{}) error
+	DeleteRun(ctx context.Context, repo Repository, runID int64) error
+	DeleteRunLogs(ctx context.Context, repo Repository, runID int64) error
+
+	// Deployments
+	ListPendingDeployments(ctx context.Context, repo Repository, runID int64) ([]PendingDeployment, error)
+	ReviewPendingDeployments(ctx context.Context, repo Repository, runID int64, environmentIDs []int64, approve bool, comment string) error
 
 	// Jobs
 	ListJobs(ctx context.Context, repo Repository, runID int64) ([]Job, error)
+	ListJobsForAttempt(ctx context.Context, repo Repository, runID int64, attempt int) ([]Job, error)
+	RerunJob(ctx context.Context, repo Repository, jobID int64, opts *RerunOpts) error
 
 	// Logs
 	GetJobLogs(ctx context.Context, repo Repository, jobID int64) (string, error)
 
 	// Rate limiting
 	RateLimitRemaining() int
+	RateLimit() RateLimit
 }
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Default HTTP timeouts
+const (
+	// DefaultConnectTimeout bounds TCP connect and TLS handshake
+	DefaultConnectTimeout = 10 * time.Second
+	// DefaultReadTimeout bounds the wait for response headers
+	DefaultReadTimeout = 30 * time.Second
+	// DefaultTimeout bounds a whole request including the response body
+	DefaultTimeout = 2 * time.Minute
+)
+
+// HTTPConfig configures the shared HTTP transport used for API calls and log downloads.
+// Zero timeouts disable the corresponding limit.
+type HTTPConfig struct {
+	ConnectTimeout time.Duration
+	ReadTimeout    time.Duration
+	Timeout        time.Duration
+	ProxyURL       string // Overrides HTTP_PROXY/HTTPS_PROXY when set
+	CAFile         string // Extra PEM bundle trusted in addition to the system roots
+}
+
+// DefaultHTTPConfig returns the default HTTP configuration.
+func DefaultHTTPConfig() HTTPConfig {
+	return HTTPConfig{
+		ConnectTimeout: DefaultConnectTimeout,
+		ReadTimeout:    DefaultReadTimeout,
+		Timeout:        DefaultTimeout,
+	}
+}
+
+// NewTransport builds an HTTP transport from the configuration.
+// It starts from http.DefaultTransport so HTTP/2 and connection pooling are kept.
+func NewTransport(cfg HTTPConfig) (*http.Transport, error) {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("default transport is not an *http.Transport")
+	}
+	transport := base.Clone()
+
+	dialer := &net.Dialer{
+		Timeout:   cfg.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	transport.DialContext = dialer.DialContext
+	transport.TLSHandshakeTimeout = cfg.ConnectTimeout
+	transport.ResponseHeaderTimeout = cfg.ReadTimeout
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL: %s", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return transport, nil
+}
+
+// loadCertPool returns the system roots with the PEM certificates from path appended.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA file: %s", path)
+	}
+	return pool, nil
+}
+
+// tokenTransport adds authorization header to requests
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Clone so the caller's request is not modified (http.RoundTripper contract)
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDefaultHTTPConfig(t *testing.T) {
+	cfg := DefaultHTTPConfig()
+
+	if cfg.ConnectTimeout != DefaultConnectTimeout {
+		t.Errorf("ConnectTimeout = %v, want %v", cfg.ConnectTimeout, DefaultConnectTimeout)
+	}
+	if cfg.ReadTimeout != DefaultReadTimeout {
+		t.Errorf("ReadTimeout = %v, want %v", cfg.ReadTimeout, DefaultReadTimeout)
+	}
+	if cfg.Timeout != DefaultTimeout {
+		t.Errorf("Timeout = %v, want %v", cfg.Timeout, DefaultTimeout)
+	}
+}
+
+func TestNewTransport_Timeouts(t *testing.T) {
+	cfg := HTTPConfig{ConnectTimeout: 3 * time.Second, ReadTimeout: 7 * time.Second}
+
+	transport, err := NewTransport(cfg)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	if transport.TLSHandshakeTimeout != 3*time.Second {
+		t.Errorf("TLSHandshakeTimeout = %v, want 3s", transport.TLSHandshakeTimeout)
+	}
+	if transport.ResponseHeaderTimeout != 7*time.Second {
+		t.Errorf("ResponseHeaderTimeout = %v, want 7s", transport.ResponseHeaderTimeout)
+	}
+}
+
+func TestNewTransport_Proxy(t *testing.T) {
+	transport, err := NewTransport(HTTPConfig{ProxyURL: "http://proxy.example.com:8080"})
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatalf("Proxy() error = %v", err)
+	}
+	if proxyURL == nil || proxyURL.Host != "proxy.example.com:8080" {
+		t.Errorf("Proxy() = %v, want proxy.example.com:8080", proxyURL)
+	}
+}
+
+func TestNewTransport_InvalidProxy(t *testing.T) {
+	if _, err := NewTransport(HTTPConfig{ProxyURL: "not a url"}); err == nil {
+		t.Error("NewTransport() should fail for an invalid proxy URL")
+	}
+}
+
+func TestNewTransport_CAFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	// Without the test CA the server certificate is not trusted
+	plain, err := NewTransport(HTTPConfig{})
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	if _, err := (&http.Client{Transport: plain}).Get(server.URL); err == nil {
+		t.Fatal("request should fail without the extra CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, encodeCertPEM(server.Certificate().Raw), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := NewTransport(HTTPConfig{CAFile: caFile})
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+		t.Error("TLS config should be set when a CA file is given")
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("request with extra CA error = %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestNewTransport_InvalidCAFile(t *testing.T) {
+	if _, err := NewTransport(HTTPConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Error("NewTransport() should fail for a missing CA file")
+	}
+
+	badFile := filepath.Join(t.TempDir(), "bad.pem")
+	if err := os.WriteFile(badFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTransport(HTTPConfig{CAFile: badFile}); err == nil {
+		t.Error("NewTransport() should fail for a file without certificates")
+	}
+}
+
+func TestTokenTransport_RoundTrip(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	transport := &tokenTransport{token: "test-token", base: http.DefaultTransport}
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if gotAuth != "Bearer test-token" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer test-token")
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Error("RoundTrip() must not modify the caller's request")
+	}
+}
+
+func TestNewClientWithConfig_InvalidConfig(t *testing.T) {
+	if _, err := NewClientWithConfig("token", "owner", "repo", HTTPConfig{ProxyURL: "::"}); err == nil {
+		t.Error("NewClientWithConfig() should fail for an invalid proxy URL")
+	}
+}
+
+// encodeCertPEM encodes a DER certificate as PEM
+func encodeCertPEM(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
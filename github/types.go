@@ -1,6 +1,9 @@
 package github
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Repository represents a GitHub repository.
 type Repository struct {
@@ -10,29 +13,84 @@ type Repository struct {
 
 // Workflow represents a GitHub Actions workflow definition.
 type Workflow struct {
-	ID    int64
-	Name  string
-	Path  string // .github/workflows/ci.yml
-	State string // active, disabled
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Path  string `json:"path"`  // .github/workflows/ci.yml
+	State string `json:"state"` // active, disabled_manually, disabled_inactivity, disabled_fork, deleted
+}
+
+// IsDisabled returns true if the workflow is disabled for any reason.
+func (w Workflow) IsDisabled() bool {
+	return strings.HasPrefix(w.State, "disabled")
+}
+
+// DisabledReason describes why the workflow is disabled, or returns "" if it is not.
+func (w Workflow) DisabledReason() string {
+	switch w.State {
+	case "disabled_manually":
+		return "Disabled manually"
+	case "disabled_inactivity":
+		return "Disabled automatically after 60 days of repository inactivity"
+	case "disabled_fork":
+		return "Disabled because the repository is a fork"
+	}
+	if w.IsDisabled() {
+		return "Disabled"
+	}
+	return ""
 }
 
 // Run represents a workflow run.
 type Run struct {
-	ID         int64
-	RunNumber  int    // Sequential run number (e.g., 21 for #21)
-	Name       string
-	Status     string // queued, in_progress, completed
-	Conclusion string // success, failure, cancelled
-	Branch     string
-	Event      string // push, pull_request, workflow_dispatch
-	CreatedAt  time.Time
-	Actor      string
-	URL        string
+	ID         int64     `json:"id"`
+	RunNumber  int       `json:"run_number"`  // Sequential run number (e.g., 21 for #21)
+	Attempt    int       `json:"run_attempt"` // 1 for the first attempt, incremented by each rerun
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`     // queued, in_progress, completed
+	Conclusion string    `json:"conclusion"` // success, failure, cancelled
+	Branch     string    `json:"branch"`
+	HeadSHA    string    `json:"head_sha"`
+	Event      string    `json:"event"` // push, pull_request, workflow_dispatch
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Actor      string    `json:"actor"`
+	URL        string    `json:"url"`
+	// PullRequests are the numbers of the pull requests the run belongs to
+	PullRequests []int `json:"pull_requests,omitempty"`
 }
 
-// IsRunning returns true if the run is in progress or queued.
+// IsRunning returns true if the run has not completed yet:
+// in progress, queued, or waiting (e.g., for a deployment approval).
 func (r Run) IsRunning() bool {
-	return r.Status == "in_progress" || r.Status == "queued"
+	switch r.Status {
+	case "in_progress", "queued", "waiting", "pending", "requested":
+		return true
+	}
+	return false
+}
+
+// IsWaiting returns true if the run is waiting for a deployment approval.
+func (r Run) IsWaiting() bool {
+	return r.Status == "waiting"
+}
+
+// Duration returns how long the run took, or has been running so far if not completed.
+// Returns 0 if the timestamps are unknown.
+func (r Run) Duration(now time.Time) time.Duration {
+	if r.CreatedAt.IsZero() {
+		return 0
+	}
+	end := now
+	if r.Status == "completed" {
+		if r.UpdatedAt.IsZero() {
+			return 0
+		}
+		end = r.UpdatedAt
+	}
+	if end.Before(r.CreatedAt) {
+		return 0
+	}
+	return end.Sub(r.CreatedAt)
 }
 
 // IsFailed returns true if the run has failed.
@@ -42,11 +100,13 @@ func (r Run) IsFailed() bool {
 
 // Job represents a job within a workflow run.
 type Job struct {
-	ID         int64
-	Name       string
-	Status     string // queued, in_progress, completed
-	Conclusion string // success, failure, cancelled
-	Steps      []Step
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`      // queued, in_progress, completed
+	Conclusion string `json:"conclusion"`  // success, failure, cancelled
+	Attempt    int    `json:"run_attempt"` // Run attempt the job belongs to
+	URL        string `json:"url"`
+	Steps      []Step `json:"steps"`
 }
 
 // IsCompleted returns true if the job has completed.
@@ -61,10 +121,53 @@ func (j Job) IsQueued() bool {
 
 // Step represents a step within a job.
 type Step struct {
-	Name       string
-	Status     string // queued, in_progress, completed
-	Conclusion string // success, failure, skipped
-	Number     int
+	Name       string `json:"name"`
+	Status     string `json:"status"`     // queued, in_progress, completed
+	Conclusion string `json:"conclusion"` // success, failure, skipped
+	Number     int    `json:"number"`
+}
+
+// PendingDeployment represents a deployment to a protected environment waiting for approval.
+type PendingDeployment struct {
+	EnvironmentID int64  `json:"environment_id"`
+	Environment   string `json:"environment"`
+	URL           string `json:"url"`
+	CanApprove    bool   `json:"can_approve"` // Whether the current user may approve or reject it
+}
+
+// Rate limit thresholds expressed as the fraction of the limit still available.
+const (
+	// RateLimitLowRatio marks the rate limit as running low
+	RateLimitLowRatio = 0.20
+	// RateLimitCriticalRatio marks the rate limit as nearly exhausted
+	RateLimitCriticalRatio = 0.05
+)
+
+// RateLimit represents the API rate limit state reported by GitHub.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time // When the current window resets
+	Resource  string    // core, search, graphql
+}
+
+// RemainingRatio returns the fraction of the limit still available.
+// Returns 1 if the limit is not known yet.
+func (r RateLimit) RemainingRatio() float64 {
+	if r.Limit <= 0 {
+		return 1
+	}
+	return float64(r.Remaining) / float64(r.Limit)
+}
+
+// IsLow returns true if the remaining requests are running low.
+func (r RateLimit) IsLow() bool {
+	return r.RemainingRatio() <= RateLimitLowRatio
+}
+
+// IsCritical returns true if the rate limit is nearly exhausted.
+func (r RateLimit) IsCritical() bool {
+	return r.RemainingRatio() <= RateLimitCriticalRatio
 }
 
 // ListRunsOpts represents options for listing workflow runs.
@@ -73,5 +176,12 @@ type ListRunsOpts struct {
 	Branch     string
 	Event      string
 	Status     string
+	HeadSHA    string // Only runs for this commit
 	PerPage    int
+	Page       int // 1-based page of PerPage runs; 0 for the first
+}
+
+// RerunOpts represents options for rerunning a run, its failed jobs, or a single job.
+type RerunOpts struct {
+	EnableDebugLogging bool // Sets ACTIONS_STEP_DEBUG and ACTIONS_RUNNER_DEBUG for the rerun
 }
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -13,6 +14,8 @@ func TestRun_IsRunning(t *testing.T) {
 	}{
 		{name: "queued", status: "queued", want: true},
 		{name: "in_progress", status: "in_progress", want: true},
+		{name: "waiting", status: "waiting", want: true},
+		{name: "pending", status: "pending", want: true},
 		{name: "completed", status: "completed", want: false},
 		{name: "cancelled", status: "cancelled", want: false},
 		{name: "empty", status: "", want: false},
@@ -29,6 +32,45 @@ func TestRun_IsRunning(t *testing.T) {
 	}
 }
 
+func TestWorkflow_IsDisabled(t *testing.T) {
+	tests := []struct {
+		state      string
+		want       bool
+		wantReason string
+	}{
+		{state: "active", want: false},
+		{state: "disabled_manually", want: true, wantReason: "manually"},
+		{state: "disabled_inactivity", want: true, wantReason: "inactivity"},
+		{state: "disabled_fork", want: true, wantReason: "fork"},
+		{state: "deleted", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			w := Workflow{State: tt.state}
+			if got := w.IsDisabled(); got != tt.want {
+				t.Errorf("Workflow.IsDisabled() = %v, want %v", got, tt.want)
+			}
+			reason := w.DisabledReason()
+			if tt.wantReason == "" && reason != "" {
+				t.Errorf("Workflow.DisabledReason() = %q, want empty", reason)
+			}
+			if !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("Workflow.DisabledReason() = %q, want to mention %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRun_IsWaiting(t *testing.T) {
+	if !(Run{Status: "waiting"}).IsWaiting() {
+		t.Error("Run.IsWaiting() = false for waiting run")
+	}
+	if (Run{Status: "in_progress"}).IsWaiting() {
+		t.Error("Run.IsWaiting() = true for in_progress run")
+	}
+}
+
 func TestRun_IsFailed(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -53,6 +95,31 @@ func TestRun_IsFailed(t *testing.T) {
 	}
 }
 
+func TestRun_Duration(t *testing.T) {
+	created := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	now := created.Add(5 * time.Minute)
+
+	tests := []struct {
+		name string
+		run  Run
+		want time.Duration
+	}{
+		{name: "running uses now", run: Run{Status: "in_progress", CreatedAt: created}, want: 5 * time.Minute},
+		{name: "completed uses updated", run: Run{Status: "completed", CreatedAt: created, UpdatedAt: created.Add(90 * time.Second)}, want: 90 * time.Second},
+		{name: "completed without updated", run: Run{Status: "completed", CreatedAt: created}, want: 0},
+		{name: "unknown created", run: Run{Status: "queued"}, want: 0},
+		{name: "clock skew", run: Run{Status: "queued", CreatedAt: now.Add(time.Minute)}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.run.Duration(now); got != tt.want {
+				t.Errorf("Run.Duration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestWorkflow_Fields(t *testing.T) {
 	w := Workflow{
 		ID:    12345,
@@ -205,3 +272,40 @@ func TestListRunsOpts_Fields(t *testing.T) {
 		t.Errorf("ListRunsOpts.PerPage = %v, want 50", opts.PerPage)
 	}
 }
+
+func TestRateLimit_Thresholds(t *testing.T) {
+	tests := []struct {
+		name         string
+		rate         RateLimit
+		wantLow      bool
+		wantCritical bool
+	}{
+		{name: "unknown limit", rate: RateLimit{}, wantLow: false, wantCritical: false},
+		{name: "plenty left", rate: RateLimit{Limit: 5000, Remaining: 4000}, wantLow: false, wantCritical: false},
+		{name: "low", rate: RateLimit{Limit: 5000, Remaining: 1000}, wantLow: true, wantCritical: false},
+		{name: "critical", rate: RateLimit{Limit: 5000, Remaining: 100}, wantLow: true, wantCritical: true},
+		{name: "exhausted", rate: RateLimit{Limit: 5000, Remaining: 0}, wantLow: true, wantCritical: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rate.IsLow(); got != tt.wantLow {
+				t.Errorf("RateLimit.IsLow() = %v, want %v", got, tt.wantLow)
+			}
+			if got := tt.rate.IsCritical(); got != tt.wantCritical {
+				t.Errorf("RateLimit.IsCritical() = %v, want %v", got, tt.wantCritical)
+			}
+		})
+	}
+}
+
+func TestRateLimit_RemainingRatio(t *testing.T) {
+	r := RateLimit{Limit: 5000, Remaining: 2500}
+	if got := r.RemainingRatio(); got != 0.5 {
+		t.Errorf("RemainingRatio() = %v, want 0.5", got)
+	}
+
+	if got := (RateLimit{}).RemainingRatio(); got != 1 {
+		t.Errorf("RemainingRatio() with unknown limit = %v, want 1", got)
+	}
+}
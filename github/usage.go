@@ -0,0 +1,97 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Usage is the billable time of a workflow or run by runner OS (UBUNTU, MACOS, WINDOWS).
+// Workflow usage covers the current billing cycle. Public repositories and
+// self-hosted runners are not billed, so Billable may be empty.
+type Usage struct {
+	Billable    map[string]time.Duration `json:"billable"`
+	RunDuration time.Duration            `json:"run_duration,omitempty"` // Runs only
+}
+
+// OSes returns the runner OSes with billable time, sorted by name.
+func (u Usage) OSes() []string {
+	oses := make([]string, 0, len(u.Billable))
+	for os := range u.Billable {
+		oses = append(oses, os)
+	}
+	sort.Strings(oses)
+	return oses
+}
+
+// TotalBillable returns the billable time across all runner OSes.
+func (u Usage) TotalBillable() time.Duration {
+	var total time.Duration
+	for _, d := range u.Billable {
+		total += d
+	}
+	return total
+}
+
+// CostRates estimates the cost of billable time.
+// GitHub bills a per-minute rate, multiplied for more expensive runner OSes.
+type CostRates struct {
+	PerMinute   float64            // USD per minute, before the OS multiplier
+	Multipliers map[string]float64 // By runner OS; OSes not listed use 1
+}
+
+// DefaultCostRates returns GitHub's list prices for hosted runners.
+func DefaultCostRates() CostRates {
+	return CostRates{
+		PerMinute: 0.008,
+		Multipliers: map[string]float64{
+			"UBUNTU":  1,
+			"WINDOWS": 2,
+			"MACOS":   10,
+		},
+	}
+}
+
+// Cost estimates the cost of d on a runner OS, rounding up to the minute.
+func (r CostRates) Cost(os string, d time.Duration) float64 {
+	multiplier, ok := r.Multipliers[os]
+	if !ok {
+		multiplier = 1
+	}
+	minutes := math.Ceil(d.Minutes())
+	return minutes * r.PerMinute * multiplier
+}
+
+// UsageCost estimates the cost of all billable time in u.
+func (r CostRates) UsageCost(u Usage) float64 {
+	var total float64
+	for os, d := range u.Billable {
+		total += r.Cost(os, d)
+	}
+	return total
+}
+
+// ParseMultipliers parses OS multipliers such as "UBUNTU=1,MACOS=10".
+// OS names are case-insensitive.
+func ParseMultipliers(spec string) (map[string]float64, error) {
+	multipliers := make(map[string]float64)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		os, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid OS multiplier %q, want OS=N", part)
+		}
+		m, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || m < 0 {
+			return nil, fmt.Errorf("invalid OS multiplier %q, want a non-negative number", part)
+		}
+		multipliers[strings.ToUpper(strings.TrimSpace(os))] = m
+	}
+	return multipliers, nil
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUsage_TotalBillable(t *testing.T) {
+	u := Usage{Billable: map[string]time.Duration{
+		"UBUNTU": 90 * time.Second,
+		"MACOS":  2 * time.Minute,
+	}}
+	if got := u.TotalBillable(); got != 210*time.Second {
+		t.Errorf("TotalBillable() = %v, want 3m30s", got)
+	}
+	if got := u.OSes(); len(got) != 2 || got[0] != "MACOS" || got[1] != "UBUNTU" {
+		t.Errorf("OSes() = %v, want sorted", got)
+	}
+}
+
+func TestCostRates_Cost(t *testing.T) {
+	rates := DefaultCostRates()
+
+	tests := []struct {
+		name string
+		os   string
+		d    time.Duration
+		want float64
+	}{
+		{"rounds up to the minute", "UBUNTU", 61 * time.Second, 0.016},
+		{"macOS multiplier", "MACOS", 10 * time.Minute, 0.8},
+		{"windows multiplier", "WINDOWS", time.Minute, 0.016},
+		{"unknown OS uses 1", "UBUNTU_ARM", time.Minute, 0.008},
+		{"no time", "MACOS", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rates.Cost(tt.os, tt.d); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Cost(%q, %v) = %v, want %v", tt.os, tt.d, got, tt.want)
+			}
+		})
+	}
+
+	u := Usage{Billable: map[string]time.Duration{"UBUNTU": time.Minute, "MACOS": time.Minute}}
+	if got := rates.UsageCost(u); math.Abs(got-0.088) > 1e-9 {
+		t.Errorf("UsageCost() = %v, want 0.088", got)
+	}
+}
+
+func TestParseMultipliers(t *testing.T) {
+	got, err := ParseMultipliers("macos=12, WINDOWS=2.5,")
+	if err != nil {
+		t.Fatalf("ParseMultipliers() error = %v", err)
+	}
+	if len(got) != 2 || got["MACOS"] != 12 || got["WINDOWS"] != 2.5 {
+		t.Errorf("ParseMultipliers() = %v", got)
+	}
+
+	for _, spec := range []string{"MACOS", "MACOS=ten", "MACOS=-1"} {
+		if _, err := ParseMultipliers(spec); err == nil {
+			t.Errorf("ParseMultipliers(%q) expected error", spec)
+		}
+	}
+}
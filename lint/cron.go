@@ -0,0 +1,87 @@
+package lint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField is a field of a POSIX cron schedule
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Names of the values from min, if any
+}
+
+// cronFields are the fields of a schedule, in order
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// ValidateCron checks a schedule in the POSIX cron syntax GitHub supports:
+// five fields of *, values, ranges, steps, and lists
+func ValidateCron(spec string) error {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("invalid cron %q: want 5 fields (minute hour day month weekday), got %d", spec, len(fields))
+	}
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if err := cronFields[i].validate(part); err != nil {
+				return fmt.Errorf("invalid cron %q: %s %v", spec, cronFields[i].name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validate checks a part of a list: *, a value, or a range, with an optional /step
+func (f cronField) validate(part string) error {
+	rng, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("%q: step must be a positive number", part)
+		}
+	}
+	if rng == "*" {
+		return nil
+	}
+	lo, hi, isRange := strings.Cut(rng, "-")
+	from, err := f.value(lo)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	to, err := f.value(hi)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("%q: range is backwards", part)
+	}
+	return nil
+}
+
+// value parses a number or name within the range of the field
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%q out of range %d-%d", s, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,37 @@
+package lint
+
+import "testing"
+
+func TestValidateCron(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"*/5 * * * *",
+		"0 0 * * 0",
+		"0,30 9-17 * * MON-FRI",
+		"15 3 1-31/2 jan,Jul *",
+		"0 12 * * sat",
+	}
+	for _, spec := range valid {
+		if err := ValidateCron(spec); err != nil {
+			t.Errorf("ValidateCron(%q) error = %v", spec, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"* * * *":      `invalid cron "* * * *": want 5 fields (minute hour day month weekday), got 4`,
+		"60 * * * *":   `invalid cron "60 * * * *": minute "60" out of range 0-59`,
+		"* * 0 * *":    `invalid cron "* * 0 * *": day of month "0" out of range 1-31`,
+		"* * * 13 *":   `invalid cron "* * * 13 *": month "13" out of range 1-12`,
+		"* * * * 7":    `invalid cron "* * * * 7": day of week "7" out of range 0-6`,
+		"*/0 * * * *":  `invalid cron "*/0 * * * *": minute "*/0": step must be a positive number`,
+		"10-5 * * * *": `invalid cron "10-5 * * * *": minute "10-5": range is backwards`,
+		"@daily":       `invalid cron "@daily": want 5 fields (minute hour day month weekday), got 1`,
+		"0 0 * * FUN":  `invalid cron "0 0 * * FUN": day of week "FUN" is not a number`,
+	}
+	for spec, want := range invalid {
+		err := ValidateCron(spec)
+		if err == nil || err.Error() != want {
+			t.Errorf("ValidateCron(%q) error = %v, want %s", spec, err, want)
+		}
+	}
+}
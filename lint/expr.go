@@ -0,0 +1,195 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// inputRefRegex matches references to workflow inputs in an expression
+// whose string literals have been blanked
+var inputRefRegex = regexp.MustCompile(`(^|[^\w.])(?:github\.event\.)?inputs\.([A-Za-z_][\w-]*)`)
+
+// expressions lints the ${{ }} expressions of every value under node,
+// and the values of "if" keys, which are expressions without ${{ }}
+func (l *linter) expressions(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for _, p := range pairs(node) {
+			if p.key.Value == "if" && p.value.Kind == yaml.ScalarNode && !strings.Contains(p.value.Value, "${{") {
+				l.expression(p.value, 0, p.value.Value)
+				continue
+			}
+			l.expressions(p.value)
+		}
+	case yaml.SequenceNode, yaml.DocumentNode:
+		for _, child := range node.Content {
+			l.expressions(child)
+		}
+	case yaml.ScalarNode:
+		l.embedded(node)
+	}
+}
+
+// embedded lints the ${{ }} expressions embedded in a scalar
+func (l *linter) embedded(node *yaml.Node) {
+	value := node.Value
+	offset := 0
+	for {
+		start := strings.Index(value[offset:], "${{")
+		if start < 0 {
+			return
+		}
+		start += offset
+		end := closingBraces(value, start+3)
+		if end < 0 {
+			line, col := l.position(node, start)
+			l.reportAt(line, col, RuleExpression, "unterminated expression: missing }}")
+			return
+		}
+		l.expression(node, start, value[start+3:end])
+		offset = end + 2
+	}
+}
+
+// closingBraces returns the index of the }} closing an expression that starts
+// at from, ignoring braces in string literals, or -1
+func closingBraces(value string, from int) int {
+	inString := false
+	for i := from; i < len(value); i++ {
+		switch {
+		case value[i] == '\'':
+			inString = !inString // An escaped '' toggles twice
+		case !inString && strings.HasPrefix(value[i:], "}}"):
+			return i
+		}
+	}
+	return -1
+}
+
+// expression lints an expression found at offset in node
+func (l *linter) expression(node *yaml.Node, offset int, expr string) {
+	line, col := l.position(node, offset)
+	blanked, msg := CheckExpression(expr)
+	if msg != "" {
+		l.reportAt(line, col, RuleExpression, "invalid expression %q: %s", strings.TrimSpace(expr), msg)
+		return
+	}
+	if l.inputs == nil {
+		return
+	}
+	for _, m := range inputRefRegex.FindAllStringSubmatch(blanked, -1) {
+		if name := m[2]; !l.inputs[name] {
+			l.reportAt(line, col, RuleInputs, "undefined input %q", name)
+		}
+	}
+}
+
+// CheckExpression checks the syntax of an expression (without ${{ }}).
+// It returns the expression with string literals blanked, and a description
+// of the first problem found, or "".
+func CheckExpression(expr string) (string, string) {
+	if strings.TrimSpace(expr) == "" {
+		return "", "empty expression"
+	}
+	if strings.Contains(expr, "${{") {
+		return "", "nested ${{"
+	}
+
+	blanked := []byte(expr)
+	var open []byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch c {
+		case '\'':
+			// Skip the string literal; '' is an escaped quote
+			j := i + 1
+			for ; j < len(expr); j++ {
+				if expr[j] == '\'' {
+					if j+1 < len(expr) && expr[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(expr) {
+				return "", "unterminated string"
+			}
+			for k := i + 1; k < j; k++ {
+				blanked[k] = ' '
+			}
+			i = j
+		case '"':
+			return "", `strings use single quotes, not "`
+		case '(', '[':
+			open = append(open, c)
+		case ')', ']':
+			want := byte('(')
+			if c == ']' {
+				want = '['
+			}
+			if len(open) == 0 || open[len(open)-1] != want {
+				return "", "unexpected " + string(c)
+			}
+			open = open[:len(open)-1]
+		case '=':
+			if next(expr, i) == '=' {
+				i++
+			} else if prev(expr, i) != '!' && prev(expr, i) != '<' && prev(expr, i) != '>' {
+				return "", "use == to compare"
+			}
+		case '&', '|':
+			if next(expr, i) != c {
+				return "", "use " + string(c) + string(c)
+			}
+			i++
+		}
+	}
+	if len(open) > 0 {
+		if open[len(open)-1] == '(' {
+			return "", "missing )"
+		}
+		return "", "missing ]"
+	}
+	return string(blanked), ""
+}
+
+// next returns the byte after i in s, or 0
+func next(s string, i int) byte {
+	if i+1 < len(s) {
+		return s[i+1]
+	}
+	return 0
+}
+
+// prev returns the byte before i in s, or 0
+func prev(s string, i int) byte {
+	if i > 0 {
+		return s[i-1]
+	}
+	return 0
+}
+
+// position returns the line and column of the byte at offset in the value of node.
+// Columns are found by searching the source line, since quoting and block
+// scalars shift values from where they appear.
+func (l *linter) position(node *yaml.Node, offset int) (int, int) {
+	before := node.Value[:offset]
+	line := node.Line + strings.Count(before, "\n")
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		line++ // Content starts below the | or > indicator
+	}
+
+	fragment := node.Value[offset:]
+	if i := strings.IndexByte(fragment, '\n'); i >= 0 {
+		fragment = fragment[:i]
+	}
+	if line-1 < len(l.lines) && fragment != "" {
+		if i := strings.Index(l.lines[line-1], fragment); i >= 0 {
+			return line, i + 1
+		}
+	}
+	return node.Line, node.Column
+}
@@ -0,0 +1,37 @@
+package lint
+
+import "testing"
+
+func TestCheckExpression(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"github.ref == 'refs/heads/main'", ""},
+		{"a != b && (c || d[0])", ""},
+		{"github.run_number >= 10", ""},
+		{"format('it''s {0}', x)", ""},
+		{"contains('a}}b', x)", ""},
+		{"  ", "empty expression"},
+		{"a = b", "use == to compare"},
+		{"a & b", "use &&"},
+		{"a | b", "use ||"},
+		{"'unterminated", "unterminated string"},
+		{"(a", "missing )"},
+		{"a[0", "missing ]"},
+		{"a)", "unexpected )"},
+		{"(a]", "unexpected ]"},
+		{`a == "b"`, `strings use single quotes, not "`},
+		{"${{ a }}", "nested ${{"},
+	}
+	for _, tt := range tests {
+		if _, got := CheckExpression(tt.expr); got != tt.want {
+			t.Errorf("CheckExpression(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+
+	blanked, _ := CheckExpression("format('inputs.x', inputs.y)")
+	if blanked != "format('        ', inputs.y)" {
+		t.Errorf("CheckExpression() blanked = %q, want string literals blanked", blanked)
+	}
+}
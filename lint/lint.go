@@ -0,0 +1,392 @@
+// Package lint checks GitHub Actions workflow files for mistakes that GitHub
+// only reports once a workflow has been pushed or dispatched.
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WorkflowsDir is the directory of workflow files, relative to the repository root
+const WorkflowsDir = ".github/workflows"
+
+// Rules reported with issues
+const (
+	RuleSyntax     = "syntax"
+	RuleSchema     = "schema"
+	RuleNeeds      = "needs"
+	RuleExpression = "expression"
+	RuleInputs     = "inputs"
+	RuleCron       = "cron"
+)
+
+// Issue is a problem found in a workflow file
+type Issue struct {
+	Path    string `json:"path"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+	Rule    string `json:"rule"`
+}
+
+// String formats the issue as "path:line:col: message (rule)"
+func (i Issue) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", i.Path, i.Line, i.Column, i.Message, i.Rule)
+}
+
+// Keys allowed in a workflow, a job, and a step
+var (
+	workflowKeys = keySet("name", "run-name", "on", "permissions", "env", "defaults", "concurrency", "jobs")
+	jobKeys      = keySet("name", "needs", "permissions", "runs-on", "environment", "concurrency", "outputs", "env",
+		"defaults", "if", "steps", "timeout-minutes", "strategy", "continue-on-error", "container", "services",
+		"uses", "with", "secrets")
+	stepKeys = keySet("id", "if", "name", "uses", "run", "shell", "with", "env", "continue-on-error",
+		"timeout-minutes", "working-directory")
+)
+
+// jobIDRegex matches valid job IDs
+var jobIDRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// yamlLineRegex extracts the line of a YAML syntax error
+var yamlLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// keySet returns a set of keys
+func keySet(keys ...string) map[string]bool {
+	set := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		set[k] = true
+	}
+	return set
+}
+
+// Dir lints the workflow files of the repository at root.
+// Paths of issues are relative to root, with forward slashes.
+func Dir(root string) ([]Issue, error) {
+	var files []string
+	for _, pattern := range []string{"*.yml", "*.yaml"} {
+		matches, err := filepath.Glob(filepath.Join(root, WorkflowsDir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	var issues []Issue
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, File(filepath.ToSlash(rel), data)...)
+	}
+	return issues, nil
+}
+
+// File lints the content of a workflow file, reporting issues under path
+func File(path string, data []byte) []Issue {
+	l := &linter{path: path, lines: strings.Split(string(data), "\n")}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		line := 1
+		msg := strings.TrimPrefix(err.Error(), "yaml: ")
+		if m := yamlLineRegex.FindStringSubmatch(msg); m != nil {
+			line, _ = strconv.Atoi(m[1])
+			msg = strings.TrimPrefix(msg, m[0]+": ")
+		}
+		l.issues = append(l.issues, Issue{Path: path, Line: line, Column: 1, Message: msg, Rule: RuleSyntax})
+		return l.issues
+	}
+	if len(doc.Content) == 0 {
+		l.issues = append(l.issues, Issue{Path: path, Line: 1, Column: 1, Message: "empty workflow file", Rule: RuleSchema})
+		return l.issues
+	}
+
+	l.workflow(doc.Content[0])
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		if l.issues[i].Line != l.issues[j].Line {
+			return l.issues[i].Line < l.issues[j].Line
+		}
+		return l.issues[i].Column < l.issues[j].Column
+	})
+	return l.issues
+}
+
+// linter collects the issues of a workflow file
+type linter struct {
+	path   string
+	lines  []string // Source lines, to locate expressions
+	issues []Issue
+
+	// Inputs of workflow_dispatch and workflow_call; nil if neither is a trigger
+	inputs map[string]bool
+}
+
+// report records an issue at node
+func (l *linter) report(node *yaml.Node, rule, format string, args ...any) {
+	l.reportAt(node.Line, node.Column, rule, format, args...)
+}
+
+// reportAt records an issue at a position
+func (l *linter) reportAt(line, col int, rule, format string, args ...any) {
+	l.issues = append(l.issues, Issue{
+		Path:    l.path,
+		Line:    line,
+		Column:  col,
+		Message: fmt.Sprintf(format, args...),
+		Rule:    rule,
+	})
+}
+
+// pair is a key and its value in a mapping
+type pair struct {
+	key, value *yaml.Node
+}
+
+// pairs returns the entries of a mapping node
+func pairs(node *yaml.Node) []pair {
+	var entries []pair
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		entries = append(entries, pair{node.Content[i], node.Content[i+1]})
+	}
+	return entries
+}
+
+// lookup returns the value of key in a mapping node, or nil
+func lookup(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for _, p := range pairs(node) {
+		if p.key.Value == key {
+			return p.value
+		}
+	}
+	return nil
+}
+
+// workflow lints the root of a workflow file
+func (l *linter) workflow(root *yaml.Node) {
+	if root.Kind != yaml.MappingNode {
+		l.report(root, RuleSchema, "workflow must be a mapping")
+		return
+	}
+	for _, p := range pairs(root) {
+		if !workflowKeys[p.key.Value] {
+			l.report(p.key, RuleSchema, "unknown key %q", p.key.Value)
+		}
+	}
+
+	on := lookup(root, "on")
+	if on == nil {
+		l.report(root, RuleSchema, `missing "on"`)
+	} else {
+		l.triggers(on)
+	}
+	jobs := lookup(root, "jobs")
+	if jobs == nil {
+		l.report(root, RuleSchema, `missing "jobs"`)
+	} else {
+		l.jobs(jobs)
+	}
+	l.expressions(root)
+}
+
+// triggers lints the "on" section: schedules, and the inputs of dispatched and called workflows
+func (l *linter) triggers(on *yaml.Node) {
+	switch on.Kind {
+	case yaml.ScalarNode:
+		l.trigger(on.Value, nil)
+	case yaml.SequenceNode:
+		for _, event := range on.Content {
+			l.trigger(event.Value, nil)
+		}
+	case yaml.MappingNode:
+		for _, p := range pairs(on) {
+			l.trigger(p.key.Value, p.value)
+		}
+	default:
+		l.report(on, RuleSchema, `"on" must be an event, a list of events, or a mapping`)
+	}
+}
+
+// trigger lints the configuration of an event
+func (l *linter) trigger(event string, config *yaml.Node) {
+	switch event {
+	case "workflow_dispatch", "workflow_call":
+		if l.inputs == nil {
+			l.inputs = make(map[string]bool)
+		}
+		if inputs := lookup(config, "inputs"); inputs != nil && inputs.Kind == yaml.MappingNode {
+			for _, p := range pairs(inputs) {
+				l.inputs[p.key.Value] = true
+			}
+		}
+	case "schedule":
+		if config == nil {
+			return
+		}
+		if config.Kind != yaml.SequenceNode {
+			l.report(config, RuleSchema, `"schedule" must be a list of cron entries`)
+			return
+		}
+		for _, entry := range config.Content {
+			cron := lookup(entry, "cron")
+			if cron == nil {
+				l.report(entry, RuleSchema, `schedule entry is missing "cron"`)
+				continue
+			}
+			if err := ValidateCron(cron.Value); err != nil {
+				l.report(cron, RuleCron, "%v", err)
+			}
+		}
+	}
+}
+
+// jobs lints the jobs of a workflow and the dependencies between them
+func (l *linter) jobs(jobs *yaml.Node) {
+	if jobs.Kind != yaml.MappingNode {
+		l.report(jobs, RuleSchema, `"jobs" must be a mapping of job IDs to jobs`)
+		return
+	}
+
+	var ids []string
+	needs := make(map[string][]*yaml.Node)
+	for _, p := range pairs(jobs) {
+		id := p.key.Value
+		ids = append(ids, id)
+		if !jobIDRegex.MatchString(id) {
+			l.report(p.key, RuleSchema, "invalid job ID %q: use letters, digits, - and _, starting with a letter or _", id)
+		}
+		needs[id] = l.job(p.key, p.value)
+	}
+
+	known := keySet(ids...)
+	for _, id := range ids {
+		for _, need := range needs[id] {
+			if !known[need.Value] {
+				l.report(need, RuleNeeds, "job %q needs unknown job %q", id, need.Value)
+			}
+		}
+	}
+	l.cycles(ids, needs)
+}
+
+// job lints a job and returns the jobs it needs.
+// Problems with the job as a whole are reported at its key.
+func (l *linter) job(key, job *yaml.Node) []*yaml.Node {
+	id := key.Value
+	if job.Kind != yaml.MappingNode {
+		l.report(key, RuleSchema, "job %q must be a mapping", id)
+		return nil
+	}
+	for _, p := range pairs(job) {
+		if !jobKeys[p.key.Value] {
+			l.report(p.key, RuleSchema, "unknown key %q in job %q", p.key.Value, id)
+		}
+	}
+
+	steps := lookup(job, "steps")
+	switch {
+	case lookup(job, "uses") != nil:
+		// Reusable workflow call: no runner or steps
+	case lookup(job, "runs-on") == nil:
+		l.report(key, RuleSchema, `job %q is missing "runs-on"`, id)
+	case steps == nil:
+		l.report(key, RuleSchema, `job %q is missing "steps"`, id)
+	}
+	if steps != nil {
+		l.steps(id, steps)
+	}
+
+	need := lookup(job, "needs")
+	switch {
+	case need == nil:
+		return nil
+	case need.Kind == yaml.ScalarNode:
+		return []*yaml.Node{need}
+	case need.Kind == yaml.SequenceNode:
+		return need.Content
+	}
+	l.report(need, RuleSchema, `"needs" of job %q must be a job ID or a list of job IDs`, id)
+	return nil
+}
+
+// steps lints the steps of a job
+func (l *linter) steps(id string, steps *yaml.Node) {
+	if steps.Kind != yaml.SequenceNode {
+		l.report(steps, RuleSchema, `"steps" of job %q must be a list`, id)
+		return
+	}
+	for i, step := range steps.Content {
+		if step.Kind != yaml.MappingNode {
+			l.report(step, RuleSchema, "step %d of job %q must be a mapping", i+1, id)
+			continue
+		}
+		for _, p := range pairs(step) {
+			if !stepKeys[p.key.Value] {
+				l.report(p.key, RuleSchema, "unknown key %q in step %d of job %q", p.key.Value, i+1, id)
+			}
+		}
+		uses, run := lookup(step, "uses"), lookup(step, "run")
+		switch {
+		case uses == nil && run == nil:
+			l.report(step, RuleSchema, `step %d of job %q needs "uses" or "run"`, i+1, id)
+		case uses != nil && run != nil:
+			l.report(step, RuleSchema, `step %d of job %q has both "uses" and "run"`, i+1, id)
+		}
+	}
+}
+
+// cycles reports dependency cycles between jobs, once per cycle
+func (l *linter) cycles(ids []string, needs map[string][]*yaml.Node) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(ids))
+	var stack []string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, need := range needs[id] {
+			switch state[need.Value] {
+			case unvisited:
+				if _, ok := needs[need.Value]; ok {
+					visit(need.Value)
+				}
+			case visiting:
+				// need closes a cycle from its first occurrence on the stack
+				start := len(stack) - 1
+				for stack[start] != need.Value {
+					start--
+				}
+				path := append(append([]string{}, stack[start:]...), need.Value)
+				l.report(need, RuleNeeds, "dependency cycle: %s", strings.Join(path, " → "))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, id := range ids {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// lintString lints a workflow and returns its issues formatted as strings
+func lintString(t *testing.T, src string) []string {
+	t.Helper()
+	var got []string
+	for _, issue := range File("ci.yml", []byte(strings.TrimLeft(src, "\n"))) {
+		got = append(got, issue.String())
+	}
+	return got
+}
+
+func assertIssues(t *testing.T, got, want []string) {
+	t.Helper()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("issues =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestFile_Valid(t *testing.T) {
+	got := lintString(t, `
+name: CI
+on:
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      environment:
+        type: string
+  schedule:
+    - cron: "30 5 * * MON-FRI"
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - run: echo "${{ inputs.environment }} ${{ github.event.inputs.environment }}"
+  test:
+    needs: build
+    if: github.ref == 'refs/heads/main' && !cancelled()
+    runs-on: ${{ matrix.os }}
+    strategy:
+      matrix:
+        os: [ubuntu-latest, windows-latest]
+    steps:
+      - run: go test ./...
+        if: ${{ contains(fromJSON('["a", "b"]'), 'a') }}
+  deploy:
+    needs: [build, test]
+    uses: ./.github/workflows/deploy.yml
+`)
+	assertIssues(t, got, nil)
+}
+
+func TestFile_Schema(t *testing.T) {
+	got := lintString(t, `
+name: CI
+on: push
+env-vars: {}
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    step:
+      - run: make
+  "bad id":
+    runs-on: ubuntu-latest
+    steps:
+      - name: Nothing to do
+      - uses: actions/checkout@v4
+        run: make
+  lint:
+    steps:
+      - run: make lint
+`)
+	assertIssues(t, got, []string{
+		`ci.yml:3:1: unknown key "env-vars" (schema)`,
+		`ci.yml:5:3: job "build" is missing "steps" (schema)`,
+		`ci.yml:7:5: unknown key "step" in job "build" (schema)`,
+		`ci.yml:9:3: invalid job ID "bad id": use letters, digits, - and _, starting with a letter or _ (schema)`,
+		`ci.yml:12:9: step 1 of job "bad id" needs "uses" or "run" (schema)`,
+		`ci.yml:13:9: step 2 of job "bad id" has both "uses" and "run" (schema)`,
+		`ci.yml:15:3: job "lint" is missing "runs-on" (schema)`,
+	})
+}
+
+func TestFile_MissingSections(t *testing.T) {
+	assertIssues(t, lintString(t, "name: CI\n"), []string{
+		`ci.yml:1:1: missing "on" (schema)`,
+		`ci.yml:1:1: missing "jobs" (schema)`,
+	})
+}
+
+func TestFile_SyntaxError(t *testing.T) {
+	got := lintString(t, `
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+   steps: []
+`)
+	assertIssues(t, got, []string{"ci.yml:2:1: did not find expected key (syntax)"})
+}
+
+func TestFile_Needs(t *testing.T) {
+	got := lintString(t, `
+on: push
+jobs:
+  a:
+    needs: c
+    runs-on: ubuntu-latest
+    steps: [{run: a}]
+  b:
+    needs: [a, biuld]
+    runs-on: ubuntu-latest
+    steps: [{run: b}]
+  c:
+    needs: [b]
+    runs-on: ubuntu-latest
+    steps: [{run: c}]
+`)
+	assertIssues(t, got, []string{
+		`ci.yml:8:13: dependency cycle: a → c → b → a (needs)`,
+		`ci.yml:8:16: job "b" needs unknown job "biuld" (needs)`,
+	})
+}
+
+func TestFile_Expressions(t *testing.T) {
+	got := lintString(t, `
+on: push
+jobs:
+  build:
+    if: github.event_name = 'push'
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.sha
+      - run: |
+          echo start
+          echo ${{ format('{0}', github.ref) }} ${{ (github.ref }}
+      - if: ${{ github.actor == "me" }}
+        run: echo ${{ }}
+`)
+	assertIssues(t, got, []string{
+		`ci.yml:4:9: invalid expression "github.event_name = 'push'": use == to compare (expression)`,
+		`ci.yml:7:19: unterminated expression: missing }} (expression)`,
+		`ci.yml:10:49: invalid expression "(github.ref": missing ) (expression)`,
+		`ci.yml:11:13: invalid expression "github.actor == \"me\"": strings use single quotes, not " (expression)`,
+		`ci.yml:12:19: invalid expression "": empty expression (expression)`,
+	})
+}
+
+func TestFile_Inputs(t *testing.T) {
+	got := lintString(t, `
+on:
+  workflow_dispatch:
+    inputs:
+      version:
+        required: true
+jobs:
+  release:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ inputs.version }} ${{ inputs.verison }}
+      - run: echo ${{ github.event.inputs.tag }} ${{ format('inputs.x') }}
+`)
+	assertIssues(t, got, []string{
+		`ci.yml:10:41: undefined input "verison" (inputs)`,
+		`ci.yml:11:19: undefined input "tag" (inputs)`,
+	})
+}
+
+func TestFile_Cron(t *testing.T) {
+	got := lintString(t, `
+on:
+  schedule:
+    - cron: "0 25 * * *"
+    - cron: "*/15 * * *"
+    - cron: "0 0 1 JAN-DEC/2 SUN,SAT"
+jobs: {}
+`)
+	assertIssues(t, got, []string{
+		`ci.yml:3:13: invalid cron "0 25 * * *": hour "25" out of range 0-23 (cron)`,
+		`ci.yml:4:13: invalid cron "*/15 * * *": want 5 fields (minute hour day month weekday), got 4 (cron)`,
+	})
+}
+
+func TestDir(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".github", "workflows")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"ci.yml":      "on: push\njobs:\n  a:\n    needs: b\n    runs-on: x\n    steps: [{run: a}]\n",
+		"deploy.yaml": "on: push\n",
+		"README.md":   "not a workflow",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues, err := Dir(root)
+	if err != nil {
+		t.Fatalf("Dir() error = %v", err)
+	}
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	assertIssues(t, got, []string{
+		`.github/workflows/ci.yml:4:12: job "a" needs unknown job "b" (needs)`,
+		`.github/workflows/deploy.yaml:1:1: missing "jobs" (schema)`,
+	})
+}
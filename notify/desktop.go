@@ -0,0 +1,44 @@
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// NotifySend shows desktop notifications through the notify-send command (libnotify).
+type NotifySend struct {
+	path string
+	run  func(name string, args ...string) error
+}
+
+// NewNotifySend returns a NotifySend notifier.
+// It fails if notify-send is not installed.
+func NewNotifySend() (*NotifySend, error) {
+	path, err := exec.LookPath("notify-send")
+	if err != nil {
+		return nil, fmt.Errorf("notify-send not found: %w", err)
+	}
+	return &NotifySend{path: path, run: runCommand}, nil
+}
+
+// Notify implements Notifier.
+func (s *NotifySend) Notify(n Notification) error {
+	if err := s.run(s.path, notifySendArgs(n)...); err != nil {
+		return fmt.Errorf("notify-send failed: %w", err)
+	}
+	return nil
+}
+
+// notifySendArgs builds the notify-send arguments for n
+func notifySendArgs(n Notification) []string {
+	urgency := "normal"
+	if n.Failed {
+		urgency = "critical"
+	}
+	return []string{"--app-name=lazyactions", "--urgency=" + urgency, "--", n.Title, n.Body}
+}
+
+// runCommand runs a command and waits for it to finish
+func runCommand(name string, args ...string) error {
+	return exec.Command(name, args...).Run()
+}
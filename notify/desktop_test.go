@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNotifySend(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+	s := &NotifySend{path: "/usr/bin/notify-send", run: func(name string, args ...string) error {
+		gotName, gotArgs = name, args
+		return nil
+	}}
+
+	if err := s.Notify(Notification{Title: "CI #3", Body: "-failed", Failed: true}); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if gotName != "/usr/bin/notify-send" {
+		t.Errorf("command = %q", gotName)
+	}
+	want := []string{"--app-name=lazyactions", "--urgency=critical", "--", "CI #3", "-failed"}
+	if !slices.Equal(gotArgs, want) {
+		t.Errorf("args = %q, want %q", gotArgs, want)
+	}
+}
+
+func TestNotifySend_Error(t *testing.T) {
+	errExit := errors.New("exit status 1")
+	s := &NotifySend{path: "notify-send", run: func(string, ...string) error { return errExit }}
+	if err := s.Notify(Notification{}); !errors.Is(err, errExit) {
+		t.Errorf("Notify() error = %v, want wrapped exit error", err)
+	}
+}
+
+func TestNotifySendArgs_Urgency(t *testing.T) {
+	if args := notifySendArgs(Notification{}); args[1] != "--urgency=normal" {
+		t.Errorf("urgency = %q, want normal", args[1])
+	}
+}
@@ -0,0 +1,65 @@
+// Package notify delivers desktop and terminal notifications.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Notification is a message shown to the user.
+type Notification struct {
+	Title  string
+	Body   string
+	Failed bool // Shown with higher urgency where supported
+}
+
+// Notifier delivers notifications.
+type Notifier interface {
+	Notify(n Notification) error
+}
+
+// Multi delivers each notification through every notifier.
+type Multi []Notifier
+
+// Notify implements Notifier. All notifiers are tried; errors are joined.
+func (m Multi) Notify(n Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Names lists the built-in notifiers accepted by New.
+var Names = []string{"bell", "osc9", "osc777", "notify-send", "none"}
+
+// New builds a notifier from a comma-separated list of built-in names
+// (e.g., "bell,notify-send"). Terminal notifiers write to w.
+// "none" or an empty spec disables notifications.
+func New(spec string, w io.Writer) (Notifier, error) {
+	var m Multi
+	for _, name := range strings.Split(spec, ",") {
+		switch strings.TrimSpace(name) {
+		case "", "none":
+		case "bell":
+			m = append(m, &Bell{W: w})
+		case "osc9":
+			m = append(m, &OSC9{W: w})
+		case "osc777":
+			m = append(m, &OSC777{W: w})
+		case "notify-send":
+			ns, err := NewNotifySend()
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, ns)
+		default:
+			return nil, fmt.Errorf("unknown notifier %q (available: %s)", name, strings.Join(Names, ", "))
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// recorder records notifications and returns err
+type recorder struct {
+	got []Notification
+	err error
+}
+
+func (r *recorder) Notify(n Notification) error {
+	r.got = append(r.got, n)
+	return r.err
+}
+
+func TestMulti_NotifiesAll(t *testing.T) {
+	errFirst := errors.New("first failed")
+	first := &recorder{err: errFirst}
+	second := &recorder{}
+
+	err := Multi{first, second}.Notify(Notification{Title: "t"})
+	if !errors.Is(err, errFirst) {
+		t.Errorf("Notify() error = %v, want first error", err)
+	}
+	if len(first.got) != 1 || len(second.got) != 1 {
+		t.Error("expected every notifier to be called despite the error")
+	}
+}
+
+func TestMulti_Empty(t *testing.T) {
+	if err := (Multi{}).Notify(Notification{}); err != nil {
+		t.Errorf("Notify() error = %v, want nil", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	var buf bytes.Buffer
+
+	tests := []struct {
+		spec    string
+		want    int
+		wantErr bool
+	}{
+		{spec: "", want: 0},
+		{spec: "none", want: 0},
+		{spec: "bell", want: 1},
+		{spec: "bell, osc9,osc777", want: 3},
+		{spec: "popup", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			n, err := New(tt.spec, &buf)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "unknown notifier") {
+					t.Errorf("New(%q) error = %v, want unknown notifier", tt.spec, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New(%q) error = %v", tt.spec, err)
+			}
+			if m := n.(Multi); len(m) != tt.want {
+				t.Errorf("New(%q) built %d notifiers, want %d", tt.spec, len(m), tt.want)
+			}
+		})
+	}
+}
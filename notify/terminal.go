@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Bell rings the terminal bell.
+type Bell struct {
+	W  io.Writer
+	mu sync.Mutex
+}
+
+// Notify implements Notifier.
+func (b *Bell) Notify(n Notification) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, err := io.WriteString(b.W, "\a")
+	return err
+}
+
+// OSC9 sends the OSC 9 escape sequence understood by iTerm2, WezTerm,
+// Windows Terminal and others. It carries a single message.
+type OSC9 struct {
+	W  io.Writer
+	mu sync.Mutex
+}
+
+// Notify implements Notifier.
+func (o *OSC9) Notify(n Notification) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := fmt.Fprintf(o.W, "\x1b]9;%s: %s\x07", sanitize(n.Title), sanitize(n.Body))
+	return err
+}
+
+// OSC777 sends the OSC 777 escape sequence understood by urxvt, foot,
+// Ghostty and others. It carries a separate title and body.
+type OSC777 struct {
+	W  io.Writer
+	mu sync.Mutex
+}
+
+// Notify implements Notifier.
+func (o *OSC777) Notify(n Notification) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	// ';' separates the fields, so it cannot appear in the title
+	title := strings.ReplaceAll(sanitize(n.Title), ";", ",")
+	_, err := fmt.Fprintf(o.W, "\x1b]777;notify;%s;%s\x07", title, sanitize(n.Body))
+	return err
+}
+
+// sanitize removes control characters that would end or corrupt an escape sequence
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return ' '
+		case r < 0x20 || r == 0x7f:
+			return -1
+		}
+		return r
+	}, s)
+}
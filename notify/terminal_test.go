@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBell(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&Bell{W: &buf}).Notify(Notification{Title: "t", Body: "b"}); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if buf.String() != "\a" {
+		t.Errorf("output = %q, want bell", buf.String())
+	}
+}
+
+func TestOSC9(t *testing.T) {
+	var buf bytes.Buffer
+	n := Notification{Title: "CI #3", Body: "failed\nin 2m"}
+	if err := (&OSC9{W: &buf}).Notify(n); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	want := "\x1b]9;CI #3: failed in 2m\x07"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestOSC777(t *testing.T) {
+	var buf bytes.Buffer
+	n := Notification{Title: "CI; #3", Body: "succeeded\x07 in 1m"}
+	if err := (&OSC777{W: &buf}).Notify(n); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	want := "\x1b]777;notify;CI, #3;succeeded in 1m\x07"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	if got := sanitize("a\x1b]0;x\x07b\tc"); got != "a]0;xb c" {
+		t.Errorf("sanitize() = %q", got)
+	}
+}
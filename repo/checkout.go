@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Checkout reads files of a local clone.
+type Checkout struct {
+	// Dir is a directory of the clone; empty means the current directory.
+	Dir string
+}
+
+// git runs a git command in the clone and returns its output.
+func (c Checkout) git(args ...string) (string, error) {
+	if c.Dir != "" {
+		args = append([]string{"-C", c.Dir}, args...)
+	}
+	out, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// ShowFile returns the content of path, relative to the repository root, at commit sha.
+// It fails when the commit has not been fetched.
+func (c Checkout) ShowFile(sha, path string) (string, error) {
+	out, err := c.git("show", sha+":"+path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s at %s: %w", path, sha, err)
+	}
+	return out, nil
+}
+
+// Root returns the top-level directory of the working copy.
+func (c Checkout) Root() (string, error) {
+	root, err := c.git("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", ErrNotGitRepository
+	}
+	return strings.TrimSpace(root), nil
+}
+
+// CurrentBranch returns the branch checked out in the working copy.
+// It fails when HEAD is detached.
+func (c Checkout) CurrentBranch() (string, error) {
+	out, err := c.git("symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve current branch: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// HeadSHA returns the full SHA of the commit checked out in the working copy.
+func (c Checkout) HeadSHA() (string, error) {
+	if _, err := c.git("rev-parse", "--git-dir"); err != nil {
+		return "", ErrNotGitRepository
+	}
+	out, err := c.git("rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// ReadFile returns the content of path, relative to the repository root, in the working copy.
+func (c Checkout) ReadFile(path string) (string, error) {
+	root, err := c.Root()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
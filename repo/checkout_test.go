@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckout(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		out, err := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...).Output()
+		if err != nil {
+			t.Fatalf("git %v: %v", args, err)
+		}
+		return string(out)
+	}
+	path := filepath.Join(dir, ".github", "workflows", "ci.yml")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("name: CI\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git("init")
+	git("add", ".")
+	git("commit", "-m", "initial")
+	sha := git("rev-parse", "HEAD")[:40]
+	if err := os.WriteFile(path, []byte("name: CI v2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// From a subdirectory, paths stay relative to the repository root
+	checkout := Checkout{Dir: filepath.Dir(path)}
+
+	got, err := checkout.ShowFile(sha, ".github/workflows/ci.yml")
+	if err != nil || got != "name: CI\n" {
+		t.Errorf("ShowFile() = %q, %v, want the committed file", got, err)
+	}
+	if _, err := checkout.ShowFile("0123456789012345678901234567890123456789", ".github/workflows/ci.yml"); err == nil {
+		t.Error("ShowFile() of an unknown commit should fail")
+	}
+
+	root, err := checkout.Root()
+	want, _ := filepath.EvalSymlinks(dir)
+	if err != nil || root != want {
+		t.Errorf("Root() = %q, %v, want %q", root, err, want)
+	}
+
+	if head, err := checkout.HeadSHA(); err != nil || head != sha {
+		t.Errorf("HeadSHA() = %q, %v, want %q", head, err, sha)
+	}
+
+	git("checkout", "-b", "feature/watch")
+	if branch, err := checkout.CurrentBranch(); err != nil || branch != "feature/watch" {
+		t.Errorf("CurrentBranch() = %q, %v, want feature/watch", branch, err)
+	}
+
+	got, err = checkout.ReadFile(".github/workflows/ci.yml")
+	if err != nil || got != "name: CI v2\n" {
+		t.Errorf("ReadFile() = %q, %v, want the working copy", got, err)
+	}
+
+	if _, err := (Checkout{Dir: t.TempDir()}).ReadFile("ci.yml"); !errors.Is(err, ErrNotGitRepository) {
+		t.Errorf("ReadFile() outside a repository error = %v, want ErrNotGitRepository", err)
+	}
+	if _, err := (Checkout{Dir: t.TempDir()}).HeadSHA(); !errors.Is(err, ErrNotGitRepository) {
+		t.Errorf("HeadSHA() outside a repository error = %v, want ErrNotGitRepository", err)
+	}
+}
@@ -0,0 +1,12 @@
+package repo
+
+// HeadSHA returns the full SHA of the commit checked out in the current directory.
+func HeadSHA() (string, error) {
+	return Checkout{}.HeadSHA()
+}
+
+// CurrentBranch returns the branch checked out in the current directory.
+// It fails when HEAD is detached.
+func CurrentBranch() (string, error) {
+	return Checkout{}.CurrentBranch()
+}
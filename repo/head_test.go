@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestHeadSHA(t *testing.T) {
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	defer os.Chdir(origDir)
+
+	t.Run("not a git repository", func(t *testing.T) {
+		if err := os.Chdir(t.TempDir()); err != nil {
+			t.Fatalf("Failed to change directory: %v", err)
+		}
+
+		_, err := HeadSHA()
+		if !errors.Is(err, ErrNotGitRepository) {
+			t.Errorf("HeadSHA() error = %v, want ErrNotGitRepository", err)
+		}
+	})
+
+	t.Run("repository without commits", func(t *testing.T) {
+		if err := os.Chdir(t.TempDir()); err != nil {
+			t.Fatalf("Failed to change directory: %v", err)
+		}
+		if err := exec.Command("git", "init").Run(); err != nil {
+			t.Fatalf("Failed to initialize git repo: %v", err)
+		}
+
+		_, err := HeadSHA()
+		if err == nil || !strings.Contains(err.Error(), "failed to resolve HEAD") {
+			t.Errorf("HeadSHA() error = %v, want HEAD resolution error", err)
+		}
+	})
+
+	t.Run("returns the checked out commit", func(t *testing.T) {
+		if err := os.Chdir(t.TempDir()); err != nil {
+			t.Fatalf("Failed to change directory: %v", err)
+		}
+		cmds := [][]string{
+			{"git", "init"},
+			{"git", "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "initial"},
+		}
+		for _, args := range cmds {
+			if err := exec.Command(args[0], args[1:]...).Run(); err != nil {
+				t.Fatalf("Failed to run %v: %v", args, err)
+			}
+		}
+		want, err := exec.Command("git", "rev-parse", "HEAD").Output()
+		if err != nil {
+			t.Fatalf("Failed to read HEAD: %v", err)
+		}
+
+		got, err := HeadSHA()
+		if err != nil {
+			t.Fatalf("HeadSHA() unexpected error: %v", err)
+		}
+		if got != strings.TrimSpace(string(want)) {
+			t.Errorf("HeadSHA() = %q, want %q", got, strings.TrimSpace(string(want)))
+		}
+		if len(got) != 40 {
+			t.Errorf("HeadSHA() = %q, want a full 40-character SHA", got)
+		}
+	})
+}
+
+func TestCurrentBranch(t *testing.T) {
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	defer os.Chdir(origDir)
+
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("Failed to change directory: %v", err)
+	}
+	cmds := [][]string{
+		{"git", "init"},
+		{"git", "checkout", "-b", "feature/watch"},
+		{"git", "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "initial"},
+	}
+	for _, args := range cmds {
+		if err := exec.Command(args[0], args[1:]...).Run(); err != nil {
+			t.Fatalf("Failed to run %v: %v", args, err)
+		}
+	}
+
+	got, err := CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch() unexpected error: %v", err)
+	}
+	if got != "feature/watch" {
+		t.Errorf("CurrentBranch() = %q, want feature/watch", got)
+	}
+
+	// Detached HEAD has no branch
+	if err := exec.Command("git", "checkout", "--detach").Run(); err != nil {
+		t.Fatalf("Failed to detach HEAD: %v", err)
+	}
+	if _, err := CurrentBranch(); err == nil {
+		t.Error("CurrentBranch() expected error for detached HEAD")
+	}
+}
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/nnnkkk7/lazyactions/app"
@@ -16,7 +17,7 @@ func TestActions_CancelRun(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -39,7 +40,7 @@ func TestActions_CancelRun(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{SuccessRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{SuccessRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -61,7 +62,7 @@ func TestActions_CancelRun(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -84,7 +85,7 @@ func TestActions_CancelRun(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -121,7 +122,7 @@ func TestActions_RerunWorkflow(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -151,7 +152,7 @@ func TestActions_RerunWorkflow(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -174,7 +175,7 @@ func TestActions_RerunFailedJobs(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{FailedRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{FailedRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -195,7 +196,7 @@ func TestActions_RerunFailedJobs(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{SuccessRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{SuccessRun()}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -282,7 +283,7 @@ func TestActions_TriggerWorkflow(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -310,7 +311,7 @@ func TestActions_TriggerWorkflow(t *testing.T) {
 }
 
 func TestActions_YankURL(t *testing.T) {
-	t.Run("y in RunsPane copies URL", func(t *testing.T) {
+	t.Run("yy in RunsPane copies URL", func(t *testing.T) {
 		run := RunningRun()
 		ta := NewTestApp(t,
 			WithMockWorkflows(DefaultTestWorkflows()),
@@ -319,21 +320,22 @@ func TestActions_YankURL(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{run}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{run}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
 
-		// Press y to yank
+		// Press y to open the yank menu, then y to yank the URL
+		ta.SendKey("y")
 		cmd := ta.SendKey("y")
 
 		// Should return flash message command
 		if cmd == nil {
-			t.Error("y should return flash message command")
+			t.Error("yy should return flash message command")
 		}
 	})
 
-	t.Run("y with no URL does nothing", func(t *testing.T) {
+	t.Run("yy with no URL does nothing", func(t *testing.T) {
 		run := github.Run{
 			ID:     100,
 			Status: "completed",
@@ -346,16 +348,17 @@ func TestActions_YankURL(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{run}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{run}})
 
 		// Move to Runs pane
 		ta.SendKey("l")
 
-		// Press y
+		// Press yy
+		ta.SendKey("y")
 		cmd := ta.SendKey("y")
 
 		if cmd != nil {
-			t.Error("y with no URL should return nil")
+			t.Error("yy with no URL should return nil")
 		}
 	})
 }
@@ -380,7 +383,7 @@ func TestActions_PaneSpecificActions(t *testing.T) {
 		)
 		ta.SetSize(120, 40)
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -399,3 +402,51 @@ func TestActions_PaneSpecificActions(t *testing.T) {
 		}
 	})
 }
+
+func TestActions_WatchRun(t *testing.T) {
+	t.Run("w marks the selected run as watched", func(t *testing.T) {
+		ta := NewTestApp(t,
+			WithMockWorkflows(DefaultTestWorkflows()),
+			WithMockRuns([]github.Run{RunningRun()}),
+		)
+		ta.SetSize(120, 40)
+
+		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
+
+		// Move to Runs pane and watch
+		ta.SendKey("l")
+		if cmd := ta.SendKey("w"); cmd == nil {
+			t.Error("w should flash a confirmation")
+		}
+
+		if !strings.Contains(ta.App.View(), "◉") {
+			t.Error("View should mark the watched run")
+		}
+	})
+
+	t.Run("completion of a watched run is reported", func(t *testing.T) {
+		ta := NewTestApp(t,
+			WithMockWorkflows(DefaultTestWorkflows()),
+			WithMockRuns([]github.Run{RunningRun()}),
+		)
+		ta.SetSize(120, 40)
+
+		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
+		ta.SendKey("l")
+		ta.SendKey("w")
+
+		finished := RunningRun()
+		finished.Status = "completed"
+		finished.Conclusion = "failure"
+		_, cmd := ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{finished}})
+		if cmd == nil {
+			t.Fatal("completion should produce commands")
+		}
+
+		if strings.Contains(ta.App.View(), "◉") {
+			t.Error("completed run should no longer be watched")
+		}
+	})
+}
@@ -98,10 +98,10 @@ func TestAppLifecycle_StartupSequence(t *testing.T) {
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: workflows})
 
 		// Step 2: Runs loaded
-		ta.App.Update(app.RunsLoadedMsg{Runs: runs})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: runs})
 
 		// Step 3: Jobs loaded
-		ta.App.Update(app.JobsLoadedMsg{Jobs: jobs})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: jobs})
 
 		// Step 4: Logs loaded (for first job ID 1001)
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: logs})
@@ -152,7 +152,7 @@ func TestAppLifecycle_EmptyData(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{}})
 
 		view := ta.App.View()
 		if len(view) == 0 {
@@ -165,8 +165,8 @@ func TestAppLifecycle_EmptyData(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: []github.Job{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: []github.Job{}})
 
 		view := ta.App.View()
 		if len(view) == 0 {
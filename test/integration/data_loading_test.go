@@ -30,7 +30,7 @@ func TestDataLoading_Success(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		view := ta.App.View()
 		if len(view) == 0 {
@@ -47,8 +47,8 @@ func TestDataLoading_Success(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		view := ta.App.View()
 		if len(view) == 0 {
@@ -66,8 +66,8 @@ func TestDataLoading_Success(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		view := ta.App.View()
@@ -109,7 +109,7 @@ func TestDataLoading_ErrorHandling(t *testing.T) {
 			ta.SetSize(120, 40)
 
 			ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-			ta.App.Update(app.RunsLoadedMsg{Err: tt.err})
+			ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Err: tt.err})
 
 			view := ta.App.View()
 			if len(view) == 0 {
@@ -122,8 +122,8 @@ func TestDataLoading_ErrorHandling(t *testing.T) {
 			ta.SetSize(120, 40)
 
 			ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-			ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-			ta.App.Update(app.JobsLoadedMsg{Err: tt.err})
+			ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+			ta.App.Update(app.JobsLoadedMsg{RunID: 100, Err: tt.err})
 
 			view := ta.App.View()
 			if len(view) == 0 {
@@ -136,8 +136,8 @@ func TestDataLoading_ErrorHandling(t *testing.T) {
 			ta.SetSize(120, 40)
 
 			ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-			ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-			ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+			ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+			ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 			ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Err: tt.err})
 
 			view := ta.App.View()
@@ -166,7 +166,7 @@ func TestDataLoading_CascadeOnNewData(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		_, cmd := ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		_, cmd := ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		if cmd == nil {
 			t.Error("RunsLoadedMsg should trigger fetchJobs command")
@@ -178,8 +178,8 @@ func TestDataLoading_CascadeOnNewData(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		_, cmd := ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		_, cmd := ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		if cmd == nil {
 			t.Error("JobsLoadedMsg should trigger fetchLogs command")
@@ -205,7 +205,7 @@ func TestDataLoading_EmptyDataHandling(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{}})
 
 		view := ta.App.View()
 		if len(view) == 0 {
@@ -218,8 +218,8 @@ func TestDataLoading_EmptyDataHandling(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: []github.Job{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: []github.Job{}})
 
 		view := ta.App.View()
 		if len(view) == 0 {
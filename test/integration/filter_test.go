@@ -117,7 +117,7 @@ func TestFilter_RunsPane(t *testing.T) {
 		)
 		ta.SetSize(120, 40)
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: runs})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: runs})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -146,7 +146,7 @@ func TestFilter_RunsPane(t *testing.T) {
 		)
 		ta.SetSize(120, 40)
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: runs})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: runs})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -178,8 +178,8 @@ func TestFilter_LogsPane(t *testing.T) {
 		)
 		ta.SetSize(120, 40)
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		// Move to Logs pane
 		ta.SendKey("l")
@@ -22,7 +22,7 @@ func TestIntegration_FullUserFlow(t *testing.T) {
 
 		// 2. Navigate to RunsPane
 		ta.SendKey("l")
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// 3. Select a running run (already selected)
 		// 4. Press 'c' to cancel
@@ -59,7 +59,7 @@ func TestIntegration_FilterAndAction(t *testing.T) {
 
 		// Load data
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: runs})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: runs})
 
 		// 1. Navigate to RunsPane
 		ta.SendKey("l")
@@ -140,11 +140,11 @@ func TestIntegration_MultiPaneWorkflow(t *testing.T) {
 
 		// 2. Navigate to runs, load and select run
 		ta.SendKey("l")
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// 3. Navigate to logs, load and view job logs
 		ta.SendKey("l")
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		// 4. Enter fullscreen log mode
@@ -177,8 +177,8 @@ func TestIntegration_RapidNavigation(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		// Rapid navigation
 		keys := []string{
@@ -213,13 +213,13 @@ func TestIntegration_ConcurrentOperations(t *testing.T) {
 
 		// Simulate multiple loads happening
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		// Simulate another round of loads
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()[:1]})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()[:2]})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()[:2]})
 
 		// View should still render correctly
 		view := ta.App.View()
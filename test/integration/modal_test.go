@@ -56,7 +56,7 @@ func TestModal_ConfirmDialog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Move to Runs pane and trigger cancel
 		ta.SendKey("l")
@@ -76,7 +76,7 @@ func TestModal_ConfirmDialog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Move to Runs pane and trigger cancel
 		ta.SendKey("l")
@@ -97,7 +97,7 @@ func TestModal_ConfirmDialog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		ta.SendKey("l")
 		ta.SendKey("c")
@@ -116,7 +116,7 @@ func TestModal_ConfirmDialog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		ta.SendKey("l")
 		ta.SendKey("c")
@@ -136,7 +136,7 @@ func TestModal_ConfirmDialog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		ta.SendKey("l")
 		ta.SendKey("c")
@@ -174,8 +174,8 @@ func TestModal_EscapeHandling(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		// Move to Logs and enter fullscreen
@@ -242,7 +242,7 @@ func TestModal_KeyBlockingInModal(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{RunningRun()}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{RunningRun()}})
 
 		// Open confirm dialog
 		ta.SendKey("l")
@@ -166,7 +166,7 @@ func TestNavigation_ListNavigationInRuns(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// Move to Runs pane
 		ta.SendKey("l")
@@ -193,8 +193,8 @@ func TestNavigation_ListNavigationInLogs(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		// Move to Logs pane
 		ta.SendKey("l")
@@ -234,7 +234,7 @@ func TestNavigation_SelectionTriggersDataLoad(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		// Move to Runs pane using j (panel navigation) and navigate in list with arrow
 		ta.SendKey("j")
@@ -254,8 +254,8 @@ func TestNavigation_SelectionTriggersDataLoad(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		// Move to Jobs pane and navigate to third job (completed "lint" job)
 		// DefaultTestJobs: [0]=completed, [1]=in_progress, [2]=completed
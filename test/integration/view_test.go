@@ -19,8 +19,8 @@ func TestView_FullscreenLog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		// Move to Logs pane
@@ -60,8 +60,8 @@ func TestView_FullscreenLog(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 		ta.App.Update(app.LogsLoadedMsg{JobID: 1001, Logs: DefaultTestLogs()})
 
 		// Enter fullscreen
@@ -134,7 +134,7 @@ func TestView_StatusBar(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
 
 		ta.SendKey("l")
 
@@ -153,8 +153,8 @@ func TestView_StatusBar(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-		ta.App.Update(app.RunsLoadedMsg{Runs: DefaultTestRuns()})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: DefaultTestJobs()})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: DefaultTestRuns()})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: DefaultTestJobs()})
 
 		ta.SendKey("l")
 		ta.SendKey("l")
@@ -206,8 +206,8 @@ func TestView_EmptyState(t *testing.T) {
 		ta.SetSize(120, 40)
 
 		ta.App.Update(app.WorkflowsLoadedMsg{Workflows: []github.Workflow{}})
-		ta.App.Update(app.RunsLoadedMsg{Runs: []github.Run{}})
-		ta.App.Update(app.JobsLoadedMsg{Jobs: []github.Job{}})
+		ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: []github.Run{}})
+		ta.App.Update(app.JobsLoadedMsg{RunID: 100, Jobs: []github.Job{}})
 
 		view := ta.App.View()
 		if len(view) == 0 {
@@ -246,7 +246,7 @@ func TestView_StatusIcons(t *testing.T) {
 			ta.SetSize(120, 40)
 
 			ta.App.Update(app.WorkflowsLoadedMsg{Workflows: DefaultTestWorkflows()})
-			ta.App.Update(app.RunsLoadedMsg{Runs: runs})
+			ta.App.Update(app.RunsLoadedMsg{WorkflowID: 1, Runs: runs})
 
 			view := ta.App.View()
 			if len(view) == 0 {